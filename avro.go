@@ -0,0 +1,44 @@
+package uuid
+
+import "fmt"
+
+// AvroFixedSchema is the Avro schema for a UUID stored as fixed(16) with the
+// uuid logical type. The 16 bytes are in canonical string order.
+const AvroFixedSchema = `{"type":"fixed","name":"uuid","size":16,"logicalType":"uuid"}`
+
+// AvroStringSchema is the Avro schema for a UUID stored as a string with the
+// uuid logical type. The value is the canonical 8-4-4-4-12 form.
+const AvroStringSchema = `{"type":"string","logicalType":"uuid"}`
+
+// AvroFixed returns the 16 raw bytes of the UUID in the layout expected by
+// AvroFixedSchema, i.e. the bytes in the order they appear in the canonical
+// string form.
+//
+// Returns:
+//   - []byte: The 16 raw bytes.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) AvroFixed() ([]byte, error) {
+	b, err := decode(u)
+	if err != nil {
+		return nil, fmt.Errorf("AvroFixed: %w", err)
+	}
+	return b[:], nil
+}
+
+// FromAvroFixed decodes an Avro fixed(16) UUID value as described by
+// AvroFixedSchema.
+//
+// Parameters:
+//   - b: The 16 raw bytes.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if b is not exactly 16 bytes long.
+func FromAvroFixed(b []byte) (UUID, error) {
+	if len(b) != 16 {
+		return "", fmt.Errorf(
+			"FromAvroFixed: expected 16 bytes for UUID, got %d", len(b),
+		)
+	}
+	return encode([16]byte(b)), nil
+}
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestAvroFixedRoundTrip(t *testing.T) {
+	for _, u := range randomUUIDs(t, 100) {
+		fixed, err := u.AvroFixed()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(fixed) != 16 {
+			t.Fatalf("AvroFixed(%s) has %d bytes, want 16", u, len(fixed))
+		}
+		back, err := FromAvroFixed(fixed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if back != u {
+			t.Errorf("round trip of %s gave %s", u, back)
+		}
+	}
+}
+
+func TestAvroFixedByteOrder(t *testing.T) {
+	fixed, err := testUUID.AvroFixed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixed[0] != 0x6f || fixed[15] != 0x6d {
+		t.Errorf("AvroFixed(%s) = %x, want canonical string order", testUUID, fixed)
+	}
+}
+
+func TestFromAvroFixedInvalidLength(t *testing.T) {
+	if _, err := FromAvroFixed(make([]byte, 15)); err == nil {
+		t.Error("FromAvroFixed with 15 bytes: expected error")
+	}
+}
@@ -0,0 +1,274 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// And returns the bitwise AND of the 16 bytes of the UUID and other. The
+// version and variant bits are the raw result of the operation and are not
+// restored.
+//
+// Parameters:
+//   - other: The second operand.
+//
+// Returns:
+//   - UUID: The result in lowercase canonical form.
+//   - error: An error if either operand is not a valid UUID.
+func (u UUID) And(other UUID) (UUID, error) {
+	a, b, err := decodePair(u, other)
+	if err != nil {
+		return "", fmt.Errorf("And: %w", err)
+	}
+	for i := range a {
+		a[i] &= b[i]
+	}
+	return encode(a), nil
+}
+
+// Or returns the bitwise OR of the 16 bytes of the UUID and other. The
+// version and variant bits are the raw result of the operation and are not
+// restored.
+//
+// Parameters:
+//   - other: The second operand.
+//
+// Returns:
+//   - UUID: The result in lowercase canonical form.
+//   - error: An error if either operand is not a valid UUID.
+func (u UUID) Or(other UUID) (UUID, error) {
+	a, b, err := decodePair(u, other)
+	if err != nil {
+		return "", fmt.Errorf("Or: %w", err)
+	}
+	for i := range a {
+		a[i] |= b[i]
+	}
+	return encode(a), nil
+}
+
+// Not returns the bitwise complement of the 16 bytes of the UUID. The
+// version and variant bits are inverted along with the rest.
+//
+// Returns:
+//   - UUID: The result in lowercase canonical form.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Not() (UUID, error) {
+	a, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("Not: %w", err)
+	}
+	for i := range a {
+		a[i] = ^a[i]
+	}
+	return encode(a), nil
+}
+
+// PairWithXORDistance returns two random Version 4, Variant 1 UUIDs whose
+// Hamming distance is exactly distance. a is random and b is a with
+// distance distinct bit positions flipped. Only the 122 random bits are
+// candidates for flipping, since the six version and variant bits must
+// match in any two v4 UUIDs; distance may therefore be at most 122.
+//
+// Parameters:
+//   - distance: The Hamming distance, in [0, 122].
+//
+// Returns:
+//   - UUID: The first UUID.
+//   - UUID: The second UUID.
+//   - error: An error if distance is out of range or crypto/rand fails.
+func PairWithXORDistance(distance int) (a UUID, b UUID, err error) {
+	var free []int
+	for i := range 128 {
+		if (i < 48 || i > 51) && i != 64 && i != 65 {
+			free = append(free, i)
+		}
+	}
+	if distance < 0 || distance > len(free) {
+		return "", "", fmt.Errorf(
+			"PairWithXORDistance: distance must be in [0, %d]: %d", len(free), distance,
+		)
+	}
+	x, err := random16()
+	if err != nil {
+		return "", "", fmt.Errorf("PairWithXORDistance: %w", err)
+	}
+	setVer4Var1(&x)
+	y := x
+	for i := range distance {
+		j, err := randInt(i, len(free)-1)
+		if err != nil {
+			return "", "", fmt.Errorf("PairWithXORDistance: %w", err)
+		}
+		free[i], free[j] = free[j], free[i]
+		y[free[i]/8] ^= 0x80 >> (free[i] % 8)
+	}
+	return encode(x), encode(y), nil
+}
+
+// NearestIn returns the candidate with the smallest Hamming distance (the
+// number of set bits in the XOR) to the receiver, together with that
+// distance. Ties go to the earliest candidate.
+//
+// Parameters:
+//   - candidates: The UUIDs to choose from.
+//
+// Returns:
+//   - UUID: The nearest candidate, as given.
+//   - int: Its Hamming distance to the receiver, in [0, 128].
+//   - error: An error if candidates is empty or any UUID is invalid.
+func (u UUID) NearestIn(candidates []UUID) (UUID, int, error) {
+	if len(candidates) == 0 {
+		return "", 0, errors.New("NearestIn: no candidates")
+	}
+	a, err := decode(u)
+	if err != nil {
+		return "", 0, fmt.Errorf("NearestIn: %w", err)
+	}
+	best, bestDist := UUID(""), -1
+	for i, c := range candidates {
+		b, err := decode(c)
+		if err != nil {
+			return "", 0, fmt.Errorf("NearestIn: candidate %d: %w", i, err)
+		}
+		if d := hamming(a, b); bestDist < 0 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best, bestDist, nil
+}
+
+// DefaultTruncationThreshold is the common prefix length, in bits, from
+// which IsSuspiciousTruncation flags two UUIDs as suspiciously similar.
+// Two random v4 UUIDs share 96 leading bits with probability about 2^-90.
+const DefaultTruncationThreshold = 96
+
+// IsSuspiciousTruncation reports whether the receiver shares at least
+// DefaultTruncationThreshold leading bits with any UUID in others, which
+// usually indicates a copy-paste or truncation bug rather than a genuine
+// collision. It is IsSuspiciousTruncationAt with the default threshold.
+//
+// Parameters:
+//   - others: The UUIDs to compare against.
+//
+// Returns:
+//   - bool: True if a suspiciously similar UUID is present.
+//   - error: An error if any UUID is invalid.
+func (u UUID) IsSuspiciousTruncation(others []UUID) (bool, error) {
+	ok, err := u.IsSuspiciousTruncationAt(others, DefaultTruncationThreshold)
+	if err != nil {
+		return false, fmt.Errorf("IsSuspiciousTruncation: %w", err)
+	}
+	return ok, nil
+}
+
+// IsSuspiciousTruncationAt reports whether the receiver shares at least
+// threshold leading bits with any UUID in others. UUIDs identical to the
+// receiver are exact duplicates rather than near misses and are ignored,
+// so others may contain the receiver itself.
+//
+// Parameters:
+//   - others: The UUIDs to compare against.
+//   - threshold: The minimum common prefix length in bits, in [1, 127].
+//
+// Returns:
+//   - bool: True if a suspiciously similar UUID is present.
+//   - error: An error if threshold is out of range or any UUID is invalid.
+func (u UUID) IsSuspiciousTruncationAt(others []UUID, threshold int) (bool, error) {
+	if threshold < 1 || threshold > 127 {
+		return false, fmt.Errorf(
+			"IsSuspiciousTruncationAt: threshold must be in [1, 127]: %d", threshold,
+		)
+	}
+	a, err := decode(u)
+	if err != nil {
+		return false, fmt.Errorf("IsSuspiciousTruncationAt: %w", err)
+	}
+	for i, o := range others {
+		b, err := decode(o)
+		if err != nil {
+			return false, fmt.Errorf(
+				"IsSuspiciousTruncationAt: element %d: %w", i, err,
+			)
+		}
+		if a != b && commonPrefixLen(a, b) >= threshold {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MinDistinguishingPrefix returns the smallest number of leading hex digits
+// of the compact form that keeps every UUID in us distinct, like the
+// shortest unambiguous abbreviation of a git commit. The UUIDs are sorted,
+// so only neighbours need comparing: if the longest common bit prefix of
+// any neighbouring pair is n bits, n/4+1 digits are needed. Casing does not
+// matter, and a single UUID needs no digits at all. The input is not
+// modified.
+//
+// Parameters:
+//   - us: The UUIDs to abbreviate.
+//
+// Returns:
+//   - int: The prefix length in hex digits, in [0, 32].
+//   - error: An error if us is empty, contains an invalid UUID, or contains
+//     the same UUID twice.
+func MinDistinguishingPrefix(us []UUID) (int, error) {
+	if len(us) == 0 {
+		return 0, errors.New("MinDistinguishingPrefix: empty input")
+	}
+	keys := make([][16]byte, len(us))
+	for i, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return 0, fmt.Errorf("MinDistinguishingPrefix: element %d: %w", i, err)
+		}
+		keys[i] = b
+	}
+	slices.SortFunc(keys, compare)
+	digits := 0
+	for i := 1; i < len(keys); i++ {
+		n := commonPrefixLen(keys[i-1], keys[i])
+		if n == 128 {
+			return 0, fmt.Errorf(
+				"MinDistinguishingPrefix: duplicate UUID: %s", encode(keys[i]),
+			)
+		}
+		digits = max(digits, n/4+1)
+	}
+	return digits, nil
+}
+
+// commonPrefixLen returns the number of leading bits shared by a and b.
+func commonPrefixLen(a, b [16]byte) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			return i*8 + bits.LeadingZeros8(x)
+		}
+	}
+	return 128
+}
+
+// hamming returns the number of differing bits between a and b.
+func hamming(a, b [16]byte) int {
+	n := 0
+	for i := range a {
+		n += bits.OnesCount8(a[i] ^ b[i])
+	}
+	return n
+}
+
+// decodePair decodes two UUIDs.
+func decodePair(u, other UUID) ([16]byte, [16]byte, error) {
+	a, err := decode(u)
+	if err != nil {
+		return a, a, err
+	}
+	b, err := decode(other)
+	if err != nil {
+		return a, b, err
+	}
+	return a, b, nil
+}
@@ -0,0 +1,233 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBitwiseNilAndMax(t *testing.T) {
+	const (
+		minUUID UUID = "00000000-0000-0000-0000-000000000000"
+		maxUUID UUID = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	)
+	ops := []struct {
+		name string
+		fn   func() (UUID, error)
+		want UUID
+	}{
+		{"u AND nil", func() (UUID, error) { return testUUID.And(minUUID) }, minUUID},
+		{"u AND max", func() (UUID, error) { return testUUID.And(maxUUID) }, testUUID},
+		{"u OR nil", func() (UUID, error) { return testUUID.Or(minUUID) }, testUUID},
+		{"u OR max", func() (UUID, error) { return testUUID.Or(maxUUID) }, maxUUID},
+		{"NOT nil", minUUID.Not, maxUUID},
+		{"NOT max", maxUUID.Not, minUUID},
+		{"NOT u", testUUID.Not, "90e5f4e3-7281-b5d4-7362-e1d0c5b4a392"},
+	}
+	for _, op := range ops {
+		got, err := op.fn()
+		if err != nil {
+			t.Fatalf("%s: %v", op.name, err)
+		}
+		if got != op.want {
+			t.Errorf("%s = %s, want %s", op.name, got, op.want)
+		}
+	}
+}
+
+func TestBitwiseErrors(t *testing.T) {
+	if _, err := testUUID.And("not-a-uuid"); err == nil {
+		t.Error("And: expected error")
+	}
+	if _, err := UUID("not-a-uuid").Or(testUUID); err == nil {
+		t.Error("Or: expected error")
+	}
+	if _, err := UUID("not-a-uuid").Not(); err == nil {
+		t.Error("Not: expected error")
+	}
+}
+
+func TestPairWithXORDistance(t *testing.T) {
+	for _, distance := range []int{0, 1, 7, 64, 122} {
+		for range 10 {
+			a, b, err := PairWithXORDistance(distance)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !IsValid(string(a)) || !IsValid(string(b)) {
+				t.Fatalf("PairWithXORDistance(%d) = %s, %s; want valid v4 UUIDs", distance, a, b)
+			}
+			x, _ := decode(a)
+			y, _ := decode(b)
+			if got := hamming(x, y); got != distance {
+				t.Errorf("PairWithXORDistance(%d): Hamming distance %d", distance, got)
+			}
+		}
+	}
+}
+
+func TestPairWithXORDistanceErrors(t *testing.T) {
+	for _, distance := range []int{-1, 123, 128} {
+		if _, _, err := PairWithXORDistance(distance); err == nil {
+			t.Errorf("PairWithXORDistance(%d): expected error", distance)
+		}
+	}
+}
+
+func TestNearestIn(t *testing.T) {
+	base, _ := decode(testUUID)
+	flip := func(positions ...int) UUID {
+		b := base
+		for _, p := range positions {
+			b[p/8] ^= 0x80 >> (p % 8)
+		}
+		return encode(b)
+	}
+	notU, _ := testUUID.Not()
+	tests := []struct {
+		name       string
+		candidates []UUID
+		want       UUID
+		dist       int
+	}{
+		{"clustered", []UUID{flip(1, 2, 3), flip(70), flip(100), flip(4, 5)}, flip(70), 1},
+		{"spread", []UUID{notU, flip(0, 8, 16, 24, 32, 40, 48, 56, 64, 72, 80), flip(127, 126)}, flip(127, 126), 2},
+		{"exact", []UUID{notU, testUUID}, testUUID, 0},
+		{"single", []UUID{notU}, notU, 128},
+	}
+	for _, tt := range tests {
+		got, dist, err := testUUID.NearestIn(tt.candidates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want || dist != tt.dist {
+			t.Errorf("%s: NearestIn() = %s, %d; want %s, %d", tt.name, got, dist, tt.want, tt.dist)
+		}
+	}
+}
+
+func TestNearestInErrors(t *testing.T) {
+	if _, _, err := testUUID.NearestIn(nil); err == nil {
+		t.Error("no candidates: expected error")
+	}
+	if _, _, err := testUUID.NearestIn([]UUID{testUUID, "not-a-uuid"}); err == nil {
+		t.Error("invalid candidate: expected error")
+	}
+	if _, _, err := UUID("not-a-uuid").NearestIn([]UUID{testUUID}); err == nil {
+		t.Error("invalid receiver: expected error")
+	}
+}
+
+func TestIsSuspiciousTruncation(t *testing.T) {
+	random := randomUUIDs(t, 50)
+	for _, u := range random {
+		got, err := u.IsSuspiciousTruncation(random)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got {
+			t.Errorf("random set: %s flagged as suspicious", u)
+		}
+	}
+	b, _ := decode(testUUID)
+	b[15] ^= 0xff
+	similar := encode(b)
+	got, err := testUUID.IsSuspiciousTruncation(append(random, similar))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got {
+		t.Errorf("%s not flagged against %s", testUUID, similar)
+	}
+	// The two share 120 leading bits: flagged at 120 but not at 121.
+	for threshold, want := range map[int]bool{96: true, 120: true, 121: false} {
+		got, err := testUUID.IsSuspiciousTruncationAt([]UUID{similar}, threshold)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("IsSuspiciousTruncationAt(%d) = %v, want %v", threshold, got, want)
+		}
+	}
+}
+
+func TestIsSuspiciousTruncationErrors(t *testing.T) {
+	for _, threshold := range []int{0, 128} {
+		if _, err := testUUID.IsSuspiciousTruncationAt(nil, threshold); err == nil {
+			t.Errorf("threshold %d: expected error", threshold)
+		}
+	}
+	if _, err := testUUID.IsSuspiciousTruncation([]UUID{"not-a-uuid"}); err == nil {
+		t.Error("invalid element: expected error")
+	}
+}
+
+func TestMinDistinguishingPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		us   []UUID
+		want int
+	}{
+		{"single", []UUID{testUUID}, 0},
+		{"far apart", []UUID{
+			"1f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+			"8f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+			"ef1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+		}, 1},
+		{"close", []UUID{
+			"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+			"6F1A0B1C-8D7F-4A2B-8C9D-1E2F3A4B5C6D",
+			"1f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+		}, 12},
+		{"last digit", []UUID{
+			"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+			"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6e",
+		}, 32},
+	}
+	for _, tt := range tests {
+		in := append([]UUID(nil), tt.us...)
+		got, err := MinDistinguishingPrefix(in)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: MinDistinguishingPrefix() = %d, %v; want %d", tt.name, got, err, tt.want)
+		}
+		for i := range in {
+			if in[i] != tt.us[i] {
+				t.Fatalf("%s: input modified", tt.name)
+			}
+		}
+	}
+}
+
+func TestMinDistinguishingPrefixIsMinimal(t *testing.T) {
+	us := randomUUIDs(t, 500)
+	n, err := MinDistinguishingPrefix(us)
+	if err != nil {
+		t.Fatal(err)
+	}
+	distinctAt := func(digits int) bool {
+		seen := make(map[string]bool, len(us))
+		for _, u := range us {
+			p := strings.ReplaceAll(string(u), "-", "")[:digits]
+			if seen[p] {
+				return false
+			}
+			seen[p] = true
+		}
+		return true
+	}
+	if !distinctAt(n) || distinctAt(n-1) {
+		t.Errorf("MinDistinguishingPrefix() = %d is not the minimal distinct prefix", n)
+	}
+}
+
+func TestMinDistinguishingPrefixErrors(t *testing.T) {
+	for name, us := range map[string][]UUID{
+		"nil":       nil,
+		"empty":     {},
+		"invalid":   {testUUID, "not-a-uuid"},
+		"duplicate": {testUUID, UUID(strings.ToUpper(string(testUUID)))},
+	} {
+		if _, err := MinDistinguishingPrefix(us); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// hexDigits is the lowercase alphabet used for canonical output.
+const hexDigits = "0123456789abcdef"
+
+// hyphenPositions lists the indexes of the hyphens in the canonical
+// 8-4-4-4-12 form.
+var hyphenPositions = [4]int{8, 13, 18, 23}
+
+// decode parses a hyphenated 8-4-4-4-12 UUID of any version or variant into
+// its 16 raw bytes. Hex digits may be upper or lower case.
+func decode(u UUID) ([16]byte, error) {
+	var b [16]byte
+	s := string(u)
+	if len(s) != 36 {
+		return b, fmt.Errorf("expected string length of 36 for UUID: %s", s)
+	}
+	for _, p := range hyphenPositions {
+		if s[p] != '-' {
+			return b, fmt.Errorf("invalid UUID input: %s", s)
+		}
+	}
+	j := 0
+	for i := 0; i < 36; {
+		if s[i] == '-' {
+			i++
+			continue
+		}
+		hi, ok1 := fromHexChar(s[i])
+		lo, ok2 := fromHexChar(s[i+1])
+		if !ok1 || !ok2 {
+			return b, fmt.Errorf("invalid UUID input: %s", s)
+		}
+		b[j] = hi<<4 | lo
+		j++
+		i += 2
+	}
+	return b, nil
+}
+
+// encode formats 16 raw bytes as a lowercase hyphenated UUID.
+func encode(b [16]byte) UUID {
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], b[10:16])
+	return UUID(buf[:])
+}
+
+// setVer4Var1 overwrites the version and variant bits of b so that it
+// conforms to Version 4 and Variant 1.
+func setVer4Var1(b *[16]byte) {
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+}
+
+// random16 returns 16 bytes read from crypto/rand.
+func random16() ([16]byte, error) {
+	var b [16]byte
+	_, err := io.ReadFull(rand.Reader, b[:])
+	return b, err
+}
+
+// fromHexChar returns the value of a single hex digit.
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// ReversedBytes returns the 16 raw bytes of the UUID with the order of all
+// bytes reversed. Unlike the Microsoft GUID layout, which only swaps the
+// first three fields, every byte moves: byte 0 becomes byte 15 and so on.
+//
+// Returns:
+//   - [16]byte: The bytes in reverse order.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) ReversedBytes() ([16]byte, error) {
+	b, err := decode(u)
+	if err != nil {
+		return b, fmt.Errorf("ReversedBytes: %w", err)
+	}
+	reverseBytes(&b)
+	return b, nil
+}
+
+// FromReversedBytes reads a UUID from bytes produced by ReversedBytes.
+//
+// Parameters:
+//   - b: The bytes in reverse order.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+func FromReversedBytes(b [16]byte) UUID {
+	reverseBytes(&b)
+	return encode(b)
+}
+
+// reverseBytes reverses b in place.
+func reverseBytes(b *[16]byte) {
+	for i, j := 0, 15; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+// ReverseHex returns the 32 lowercase hex digits of the UUID's bytes in
+// reverse byte order, for comparison against little-endian dumps. Bytes,
+// not characters, are reversed, so each byte keeps its two digits in order:
+// 01234567-89ab-cdef-0123-456789abcdef becomes
+// "efcdab8967452301efcdab8967452301", whereas reversing the characters
+// would give "fedcba9876543210fedcba9876543210". The result is the hex of
+// ReversedBytes.
+//
+// Returns:
+//   - string: The 32 hex digits in reverse byte order.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) ReverseHex() (string, error) {
+	b, err := u.ReversedBytes()
+	if err != nil {
+		return "", fmt.Errorf("ReverseHex: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// FromReverseHex parses 32 hex digits produced by ReverseHex or read from a
+// little-endian dump.
+//
+// Parameters:
+//   - s: The 32 hex digits in reverse byte order.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if s is not 32 hex digits.
+func FromReverseHex(s string) (UUID, error) {
+	b, err := decodeCompact(s)
+	if err != nil {
+		return "", fmt.Errorf("FromReverseHex: %w", err)
+	}
+	return FromReversedBytes(b), nil
+}
+
+// AppendBytesAll appends the 16 raw bytes of each UUID in us to dst and
+// returns the extended slice. dst is grown at most once, so serializing a
+// whole slice costs at most a single allocation, and none if dst already
+// has room for 16×len(us) more bytes.
+//
+// Parameters:
+//   - dst: The buffer to append to.
+//   - us: The UUIDs to append, in order.
+//
+// Returns:
+//   - []byte: The extended buffer.
+//   - error: An error naming the index of the first invalid UUID.
+func AppendBytesAll(dst []byte, us []UUID) ([]byte, error) {
+	dst = slices.Grow(dst, 16*len(us))
+	for i, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return nil, fmt.Errorf("AppendBytesAll: element %d: %w", i, err)
+		}
+		dst = append(dst, b[:]...)
+	}
+	return dst, nil
+}
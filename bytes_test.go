@@ -0,0 +1,134 @@
+package uuid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReversedBytesRoundTrip(t *testing.T) {
+	for _, u := range randomUUIDs(t, 100) {
+		r, err := u.ReversedBytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := FromReversedBytes(r); got != u {
+			t.Errorf("FromReversedBytes(%s.ReversedBytes()) = %s", u, got)
+		}
+	}
+}
+
+func TestReversedBytesLayout(t *testing.T) {
+	r, err := testUUID.ReversedBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r[0] != 0x6d || r[15] != 0x6f {
+		t.Errorf("ReversedBytes(%s) = %x, want fully reversed bytes", testUUID, r)
+	}
+	if _, err := UUID("bad").ReversedBytes(); err == nil {
+		t.Error("ReversedBytes on invalid UUID: expected error")
+	}
+}
+
+func TestReverseHex(t *testing.T) {
+	const u UUID = "01234567-89ab-cdef-0123-456789abcdef"
+	const want = "efcdab8967452301efcdab8967452301"
+	got, err := u.ReverseHex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("ReverseHex() = %q, want %q", got, want)
+	}
+	for _, s := range []string{want, strings.ToUpper(want)} {
+		back, err := FromReverseHex(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if back != u {
+			t.Errorf("FromReverseHex(%q) = %s, want %s", s, back, u)
+		}
+	}
+	if _, err := UUID("not-a-uuid").ReverseHex(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+	for _, s := range []string{"", want[:30], want + "00", string(u)} {
+		if _, err := FromReverseHex(s); err == nil {
+			t.Errorf("FromReverseHex(%q): expected error", s)
+		}
+	}
+}
+
+func TestAppendBytesAll(t *testing.T) {
+	us := randomUUIDs(t, 5)
+	prefix := []byte("hdr")
+	got, err := AppendBytesAll(prefix, us)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte("hdr")
+	for _, u := range us {
+		b, _ := decode(u)
+		want = append(want, b[:]...)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("AppendBytesAll() = %x, want %x", got, want)
+	}
+}
+
+func TestAppendBytesAllAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector adds allocations")
+	}
+	us := randomUUIDs(t, 5)
+	dst := make([]byte, 0, 16*len(us))
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := AppendBytesAll(dst[:0], us); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("AppendBytesAll into a large enough buffer allocated %v times", allocs)
+	}
+	allocs = testing.AllocsPerRun(100, func() {
+		if _, err := AppendBytesAll(nil, us); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 1 {
+		t.Errorf("AppendBytesAll(nil) allocated %v times, want 1", allocs)
+	}
+}
+
+func TestAppendBytesAllError(t *testing.T) {
+	_, err := AppendBytesAll(nil, []UUID{testUUID, testUUID, "not-a-uuid"})
+	if err == nil || !strings.Contains(err.Error(), "element 2") {
+		t.Errorf("error = %v, want one naming element 2", err)
+	}
+}
+
+func BenchmarkAppendBytesAll(b *testing.B) {
+	us := randomUUIDs(b, 1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := AppendBytesAll(nil, us); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendBytesEach(b *testing.B) {
+	us := randomUUIDs(b, 1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		var dst []byte
+		for _, u := range us {
+			raw, err := decode(u)
+			if err != nil {
+				b.Fatal(err)
+			}
+			dst = append(dst, raw[:]...)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestCategoryRoundTrip(t *testing.T) {
+	pairs := [][2]uint8{{0, 0}, {0, 15}, {15, 0}, {15, 15}, {3, 7}, {10, 1}}
+	for _, p := range pairs {
+		u, err := Ver4WithCategory(p[0], p[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) {
+			t.Errorf("Ver4WithCategory(%d, %d) = %s, want a valid v4 UUID", p[0], p[1], u)
+		}
+		cat, subcat, err := u.Category()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cat != p[0] || subcat != p[1] {
+			t.Errorf("%s.Category() = %d, %d; want %d, %d", u, cat, subcat, p[0], p[1])
+		}
+	}
+}
+
+func TestCategoryErrors(t *testing.T) {
+	for _, p := range [][2]uint8{{16, 0}, {0, 16}, {255, 255}} {
+		if _, err := Ver4WithCategory(p[0], p[1]); err == nil {
+			t.Errorf("Ver4WithCategory(%d, %d): expected error", p[0], p[1])
+		}
+	}
+	if _, _, err := UUID("not-a-uuid").Category(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
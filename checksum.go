@@ -0,0 +1,87 @@
+package uuid
+
+import "fmt"
+
+// Ver4WithCRC generates a random Version 4, Variant 1 UUID whose last byte
+// is replaced by a CRC-8 (polynomial 0x07, initial value 0) of the preceding
+// 15 bytes. This reduces the random bits from 122 to 114 in exchange for
+// detecting corrupted IDs without a lookup; see VerifyCRC.
+//
+// Returns:
+//   - UUID: A random UUID with an embedded CRC-8.
+//   - error: An error if crypto/rand fails.
+func Ver4WithCRC() (UUID, error) {
+	b, err := random16()
+	if err != nil {
+		return "", fmt.Errorf("Ver4WithCRC: %w", err)
+	}
+	setVer4Var1(&b)
+	b[15] = crc8(b[:15])
+	return encode(b), nil
+}
+
+// VerifyCRC reports whether the last byte of the UUID matches the CRC-8 of
+// the preceding 15 bytes, as produced by Ver4WithCRC.
+//
+// Returns:
+//   - bool: True if the checksum matches.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) VerifyCRC() (bool, error) {
+	b, err := decode(u)
+	if err != nil {
+		return false, fmt.Errorf("VerifyCRC: %w", err)
+	}
+	return b[15] == crc8(b[:15]), nil
+}
+
+// Ver4WithParity generates a random Version 4, Variant 1 UUID whose lowest
+// bit (bit 0 of the last byte) is an even parity bit over all 128 bits:
+// it is set so that the total number of one bits is even. Any single-bit
+// error, including one in the version or variant bits, and more generally
+// any odd number of flipped bits, makes the count odd and is detected by
+// CheckParity; an even number of flips is not. The parity bit reduces the
+// random bits from 122 to 121.
+//
+// Returns:
+//   - UUID: A random UUID with even parity.
+//   - error: An error if crypto/rand fails.
+func Ver4WithParity() (UUID, error) {
+	b, err := random16()
+	if err != nil {
+		return "", fmt.Errorf("Ver4WithParity: %w", err)
+	}
+	setVer4Var1(&b)
+	b[15] &^= 1
+	b[15] |= byte(hamming(b, [16]byte{}) & 1)
+	return encode(b), nil
+}
+
+// CheckParity reports whether the UUID has an even number of one bits, as
+// produced by Ver4WithParity.
+//
+// Returns:
+//   - bool: True if the parity is intact.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) CheckParity() (bool, error) {
+	b, err := decode(u)
+	if err != nil {
+		return false, fmt.Errorf("CheckParity: %w", err)
+	}
+	return hamming(b, [16]byte{})&1 == 0, nil
+}
+
+// crc8 computes the CRC-8 of data with polynomial 0x07 and initial value 0.
+func crc8(data []byte) byte {
+	var crc byte
+	for _, d := range data {
+		crc ^= d
+		for range 8 {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,61 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestVerifyCRC(t *testing.T) {
+	for range 100 {
+		u, err := Ver4WithCRC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) {
+			t.Fatalf("Ver4WithCRC() = %s, want a valid v4 UUID", u)
+		}
+		ok, err := u.VerifyCRC()
+		if err != nil || !ok {
+			t.Fatalf("VerifyCRC(%s) = %v, %v; want true", u, ok, err)
+		}
+		b, _ := decode(u)
+		for bit := range 120 {
+			c := b
+			c[bit/8] ^= 0x80 >> (bit % 8)
+			if ok, _ := encode(c).VerifyCRC(); ok {
+				t.Fatalf("flipping bit %d of %s went undetected", bit, u)
+			}
+		}
+	}
+}
+
+func TestCheckParityDetectsBitFlip(t *testing.T) {
+	for range 50 {
+		u, err := Ver4WithParity()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) {
+			t.Fatalf("Ver4WithParity() = %s, want a valid v4 UUID", u)
+		}
+		ok, err := u.CheckParity()
+		if err != nil || !ok {
+			t.Fatalf("CheckParity(%s) = %v, %v; want true", u, ok, err)
+		}
+		b, _ := decode(u)
+		for bit := range 128 {
+			c := b
+			c[bit/8] ^= 0x80 >> (bit % 8)
+			if ok, _ := encode(c).CheckParity(); ok {
+				t.Fatalf("flipping bit %d of %s went undetected", bit, u)
+			}
+		}
+		c := b
+		c[0] ^= 0xc0
+		if ok, _ := encode(c).CheckParity(); !ok {
+			t.Errorf("two flipped bits in %s changed the parity", u)
+		}
+	}
+	if _, err := UUID("not-a-uuid").CheckParity(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
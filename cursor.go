@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// cursorEncoding is a URL-safe Base64 encoding whose alphabet is in ASCII
+// order, so that fixed-length encodings sort like the bytes they encode.
+var cursorEncoding = base64.NewEncoding(
+	"-0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz",
+).WithPadding(base64.NoPadding).Strict()
+
+// PaginationCursor returns an opaque, URL-safe cursor for a Version 7 UUID.
+// The cursor is the 22-character unpadded Base64 encoding of the 16 raw
+// bytes in an ASCII-ordered alphabet, so cursors sort lexicographically in
+// the same order as the UUIDs and hence their embedded timestamps.
+//
+// Returns:
+//   - string: The cursor.
+//   - error: An error if the receiver is not a valid Version 7 UUID.
+func (u UUID) PaginationCursor() (string, error) {
+	b, err := decodeV7(u)
+	if err != nil {
+		return "", fmt.Errorf("PaginationCursor: %w", err)
+	}
+	return cursorEncoding.EncodeToString(b[:]), nil
+}
+
+// FromPaginationCursor decodes a cursor produced by PaginationCursor.
+//
+// Parameters:
+//   - s: The cursor to decode.
+//
+// Returns:
+//   - UUID: The Version 7 UUID in lowercase canonical form.
+//   - error: An error if s is not a valid cursor for a Version 7 UUID.
+func FromPaginationCursor(s string) (UUID, error) {
+	if len(s) != 22 {
+		return "", fmt.Errorf("FromPaginationCursor: invalid cursor: %s", s)
+	}
+	var b [16]byte
+	if _, err := cursorEncoding.Decode(b[:], []byte(s)); err != nil {
+		return "", fmt.Errorf("FromPaginationCursor: %w", err)
+	}
+	u := encode(b)
+	if _, err := decodeV7(u); err != nil {
+		return "", fmt.Errorf("FromPaginationCursor: %w", err)
+	}
+	return u, nil
+}
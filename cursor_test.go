@@ -0,0 +1,44 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPaginationCursorOrdering(t *testing.T) {
+	start := time.Now()
+	var us []UUID
+	for i := range 50 {
+		us = append(us, v7At(t, start.Add(time.Duration(i)*time.Millisecond)))
+	}
+	cursors := make([]string, len(us))
+	for i, u := range us {
+		c, err := u.PaginationCursor()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cursors[i] = c
+		got, err := FromPaginationCursor(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != u {
+			t.Errorf("FromPaginationCursor(%q) = %s, want %s", c, got, u)
+		}
+	}
+	if !slices.IsSorted(cursors) {
+		t.Errorf("cursors are not sorted in timestamp order: %v", cursors)
+	}
+}
+
+func TestPaginationCursorErrors(t *testing.T) {
+	if _, err := testUUID.PaginationCursor(); err == nil {
+		t.Error("v4 UUID: expected error")
+	}
+	for _, s := range []string{"", "short", "!!!!!!!!!!!!!!!!!!!!!!"} {
+		if _, err := FromPaginationCursor(s); err == nil {
+			t.Errorf("FromPaginationCursor(%q): expected error", s)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// DeriveN deterministically derives the n-th child of the UUID. The base
+// UUID's 16 bytes and n (big-endian) are hashed with SHA-256 and the first
+// 16 bytes of the digest are shaped as a Version 4, Variant 1 UUID. The same
+// base and n always yield the same child, and different values of n yield
+// distinct children with overwhelming probability.
+//
+// Parameters:
+//   - n: The index of the child to derive.
+//
+// Returns:
+//   - UUID: The derived child UUID.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) DeriveN(n uint32) (UUID, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("DeriveN: %w", err)
+	}
+	var buf [20]byte
+	copy(buf[:16], b[:])
+	binary.BigEndian.PutUint32(buf[16:], n)
+	return deriveFrom(buf[:]), nil
+}
+
+// Derive deterministically derives a child of the UUID identified by label.
+// The base UUID's 16 bytes, the label and the label's length (as a
+// big-endian uint64) are hashed with SHA-256 and the first 16 bytes of the
+// digest are shaped as a Version 4, Variant 1 UUID. The trailing length keeps
+// the hashed input longer than DeriveN's, so the two never collide.
+//
+// Parameters:
+//   - label: The name of the child to derive.
+//
+// Returns:
+//   - UUID: The derived child UUID.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Derive(label string) (UUID, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("Derive: %w", err)
+	}
+	buf := make([]byte, 0, len(b)+len(label)+8)
+	buf = append(buf, b[:]...)
+	buf = append(buf, label...)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(len(label)))
+	return deriveFrom(buf), nil
+}
+
+// IsChildOf reports whether the UUID equals parent.Derive(label). Since
+// derivation is one-way, this verifies a claimed parent-child relationship
+// without storing the parent.
+//
+// Parameters:
+//   - parent: The claimed parent UUID.
+//   - label: The label the child was derived with.
+//
+// Returns:
+//   - bool: True if the receiver is the derived child.
+//   - error: An error if the receiver or parent is not a valid UUID.
+func (u UUID) IsChildOf(parent UUID, label string) (bool, error) {
+	b, err := decode(u)
+	if err != nil {
+		return false, fmt.Errorf("IsChildOf: %w", err)
+	}
+	child, err := parent.Derive(label)
+	if err != nil {
+		return false, fmt.Errorf("IsChildOf: parent: %w", err)
+	}
+	return encode(b) == child, nil
+}
+
+// deriveFrom hashes data with SHA-256 and shapes the first 16 bytes of the
+// digest as a Version 4, Variant 1 UUID.
+func deriveFrom(data []byte) UUID {
+	sum := sha256.Sum256(data)
+	var out [16]byte
+	copy(out[:], sum[:16])
+	setVer4Var1(&out)
+	return encode(out)
+}
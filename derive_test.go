@@ -0,0 +1,91 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeriveNDeterministic(t *testing.T) {
+	a, err := testUUID.DeriveN(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := testUUID.DeriveN(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("DeriveN(7) not deterministic: %s != %s", a, b)
+	}
+	if !IsValid(string(a)) {
+		t.Errorf("DeriveN(7) = %s, want a valid v4 UUID", a)
+	}
+}
+
+func TestDeriveNNoCollisions(t *testing.T) {
+	const n = 10000
+	seen := make(map[UUID]uint32, n)
+	for i := range uint32(n) {
+		c, err := testUUID.DeriveN(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j, ok := seen[c]; ok {
+			t.Fatalf("DeriveN(%d) and DeriveN(%d) collide: %s", i, j, c)
+		}
+		seen[c] = i
+	}
+}
+
+func TestDeriveNInvalid(t *testing.T) {
+	if _, err := UUID("not-a-uuid").DeriveN(0); err == nil {
+		t.Error("DeriveN on invalid UUID: expected error")
+	}
+}
+
+func TestIsChildOf(t *testing.T) {
+	child, err := testUUID.Derive("orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsValid(string(child)) {
+		t.Errorf("Derive() = %s, want a valid v4 UUID", child)
+	}
+	upper := UUID(strings.ToUpper(string(child)))
+	for _, c := range []UUID{child, upper} {
+		ok, err := c.IsChildOf(testUUID, "orders")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Errorf("%s.IsChildOf(%s, %q) = false, want true", c, testUUID, "orders")
+		}
+	}
+	other := UUID("6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6e")
+	negatives := []struct {
+		parent UUID
+		label  string
+	}{
+		{testUUID, "order"},
+		{testUUID, ""},
+		{other, "orders"},
+	}
+	for _, n := range negatives {
+		ok, err := child.IsChildOf(n.parent, n.label)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Errorf("IsChildOf(%s, %q) = true, want false", n.parent, n.label)
+		}
+	}
+}
+
+func TestIsChildOfErrors(t *testing.T) {
+	if _, err := UUID("not-a-uuid").IsChildOf(testUUID, "x"); err == nil {
+		t.Error("invalid receiver: expected error")
+	}
+	if _, err := testUUID.IsChildOf("not-a-uuid", "x"); err == nil {
+		t.Error("invalid parent: expected error")
+	}
+}
@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// gregorianOffset is the number of 100-nanosecond intervals between the
+// Gregorian epoch (1582-10-15) used by Versions 1 and 6 and the Unix epoch.
+const gregorianOffset = 0x01b21dd213814000
+
+// Description bundles a UUID with properties derived from it.
+type Description struct {
+	// Canonical is the lowercase hyphenated form.
+	Canonical string `json:"canonical"`
+	// Version is the value of the version nibble.
+	Version int `json:"version"`
+	// Variant names the variant: "NCS", "RFC 4122", "Microsoft" or
+	// "Future".
+	Variant string `json:"variant"`
+	// Compact is the lowercase form without hyphens.
+	Compact string `json:"compact"`
+	// Timestamp is the embedded creation time for the time-based Versions
+	// 1, 6 and 7, and nil otherwise.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// Describe returns the UUID together with its derived properties in a form
+// suitable for JSON APIs.
+//
+// Returns:
+//   - Description: The UUID's description.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Describe() (Description, error) {
+	b, err := decode(u)
+	if err != nil {
+		return Description{}, fmt.Errorf("Describe: %w", err)
+	}
+	c := string(encode(b))
+	d := Description{
+		Canonical: c,
+		Version:   int(b[6] >> 4),
+		Variant:   variantName(b),
+		Compact:   c[0:8] + c[9:13] + c[14:18] + c[19:23] + c[24:36],
+	}
+	if ts, ok := timestamp(b); ok {
+		ts = ts.UTC()
+		d.Timestamp = &ts
+	}
+	return d, nil
+}
+
+// variantName names the variant encoded in the high bits of byte 8.
+func variantName(b [16]byte) string {
+	switch {
+	case b[8]&0x80 == 0:
+		return "NCS"
+	case b[8]&0xc0 == 0x80:
+		return "RFC 4122"
+	case b[8]&0xe0 == 0xc0:
+		return "Microsoft"
+	}
+	return "Future"
+}
+
+// timestamp returns the creation time embedded in Version 1, 6 and 7 UUIDs.
+func timestamp(b [16]byte) (time.Time, bool) {
+	if b[8]&0xc0 != 0x80 {
+		return time.Time{}, false
+	}
+	switch b[6] >> 4 {
+	case 1:
+		ticks := uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)<<48 |
+			uint64(binary.BigEndian.Uint16(b[4:6]))<<32 |
+			uint64(binary.BigEndian.Uint32(b[0:4]))
+		return gregorianTime(ticks), true
+	case 6:
+		ticks := binary.BigEndian.Uint64(b[0:8])
+		ticks = ticks>>16<<12 | ticks&0x0fff
+		return gregorianTime(ticks), true
+	case 7:
+		return time.UnixMilli(v7Millis(b)), true
+	}
+	return time.Time{}, false
+}
+
+// gregorianTime converts 100-nanosecond ticks since the Gregorian epoch to
+// a time.Time.
+func gregorianTime(ticks uint64) time.Time {
+	unix := int64(ticks) - gregorianOffset
+	return time.Unix(unix/1e7, unix%1e7*100)
+}
@@ -0,0 +1,43 @@
+package uuid
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDescribeV4JSON(t *testing.T) {
+	d, err := testUUID.Describe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"canonical":"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d","version":4,` +
+		`"variant":"RFC 4122","compact":"6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d"}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
+
+func TestDescribeV7JSON(t *testing.T) {
+	ts := time.UnixMilli(1718445600123)
+	u := v7At(t, ts)
+	d, err := u.Describe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Version != 7 || d.Timestamp == nil || !d.Timestamp.Equal(ts) {
+		t.Fatalf("got %+v, want version 7 at %s", d, ts)
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"timestamp":"2024-06-15T10:00:00.123Z"`) {
+		t.Errorf("JSON lacks UTC timestamp: %s", data)
+	}
+}
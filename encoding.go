@@ -0,0 +1,189 @@
+package uuid
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// base58Alphabet is the Bitcoin Base58 alphabet.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DetectEncoding returns the encodings under which s decodes to a valid
+// UUID, so that a caller can ask the user to disambiguate when more than one
+// applies. Encodings are checked, and listed, in this order:
+//   - "canonical": 36 characters, hex in 8-4-4-4-12 groups.
+//   - "braced": the canonical form wrapped in '{' and '}'.
+//   - "urn": the canonical form prefixed with "urn:uuid:".
+//   - "compact": 32 hex characters without hyphens.
+//   - "base58": the 16 bytes as a Bitcoin Base58 number, at most 22
+//     characters, with one leading '1' per leading zero byte.
+//   - "base64": the 16 bytes in standard or URL-safe Base64, with or without
+//     padding, rejecting non-zero trailing bits.
+//
+// Any version and variant is accepted. An empty result means s is not a
+// UUID under any supported encoding.
+//
+// Parameters:
+//   - s: The string to inspect.
+//
+// Returns:
+//   - []string: The names of all plausible encodings.
+func DetectEncoding(s string) []string {
+	var out []string
+	if _, err := decode(UUID(s)); err == nil {
+		out = append(out, "canonical")
+	}
+	if len(s) == 38 && s[0] == '{' && s[37] == '}' {
+		if _, err := decode(UUID(s[1:37])); err == nil {
+			out = append(out, "braced")
+		}
+	}
+	if len(s) == 45 && strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		if _, err := decode(UUID(s[len(urnPrefix):])); err == nil {
+			out = append(out, "urn")
+		}
+	}
+	if _, err := decodeCompact(s); err == nil {
+		out = append(out, "compact")
+	}
+	if _, ok := decodeBase58(s); ok {
+		out = append(out, "base58")
+	}
+	if _, ok := decodeBase64(s); ok {
+		out = append(out, "base64")
+	}
+	return out
+}
+
+// Formats holds every supported representation of a UUID.
+type Formats struct {
+	// Canonical is the lowercase 8-4-4-4-12 form.
+	Canonical string `json:"canonical"`
+	// Compact is the lowercase form without hyphens.
+	Compact string `json:"compact"`
+	// Braced is the canonical form wrapped in '{' and '}'.
+	Braced string `json:"braced"`
+	// URN is the canonical form prefixed with "urn:uuid:".
+	URN string `json:"urn"`
+	// Base58 is the Bitcoin Base58 encoding of the bytes.
+	Base58 string `json:"base58"`
+	// Base64 is the unpadded URL-safe Base64 encoding of the bytes.
+	Base64 string `json:"base64"`
+	// Base32 is the unpadded standard Base32 encoding of the bytes.
+	Base32 string `json:"base32"`
+	// Raw is the 16 raw bytes.
+	Raw [16]byte `json:"raw"`
+}
+
+// AllFormats returns the UUID in every supported representation at once.
+//
+// Returns:
+//   - Formats: The representations.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) AllFormats() (Formats, error) {
+	b, err := decode(u)
+	if err != nil {
+		return Formats{}, fmt.Errorf("AllFormats: %w", err)
+	}
+	c := string(encode(b))
+	return Formats{
+		Canonical: c,
+		Compact:   c[0:8] + c[9:13] + c[14:18] + c[19:23] + c[24:36],
+		Braced:    "{" + c + "}",
+		URN:       urnPrefix + c,
+		Base58:    encodeBase58(b),
+		Base64:    base64.RawURLEncoding.EncodeToString(b[:]),
+		Base32:    base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b[:]),
+		Raw:       b,
+	}, nil
+}
+
+// encodeBase58 encodes b as a Bitcoin Base58 number, emitting one leading
+// '1' per leading zero byte.
+func encodeBase58(b [16]byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	// 16 bytes need at most 22 Base58 digits.
+	var digits [22]byte
+	n := 0
+	num := b
+	for start := zeros; start < len(num); {
+		rem := 0
+		for i := start; i < len(num); i++ {
+			acc := rem<<8 | int(num[i])
+			num[i] = byte(acc / 58)
+			rem = acc % 58
+		}
+		digits[n] = base58Alphabet[rem]
+		n++
+		for start < len(num) && num[start] == 0 {
+			start++
+		}
+	}
+	out := make([]byte, zeros+n)
+	for i := 0; i < zeros; i++ {
+		out[i] = '1'
+	}
+	for i := 0; i < n; i++ {
+		out[zeros+i] = digits[n-1-i]
+	}
+	return string(out)
+}
+
+// decodeBase58 decodes a string produced by encodeBase58. Only the exact
+// encoding of some 16-byte value is accepted.
+func decodeBase58(s string) ([16]byte, bool) {
+	var b [16]byte
+	if len(s) == 0 || len(s) > 22 {
+		return b, false
+	}
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(base58Alphabet, s[i])
+		if d < 0 {
+			return b, false
+		}
+		carry := d
+		for j := len(b) - 1; j >= 0; j-- {
+			acc := int(b[j])*58 + carry
+			b[j] = byte(acc)
+			carry = acc >> 8
+		}
+		if carry != 0 {
+			return b, false
+		}
+	}
+	if encodeBase58(b) != s {
+		return b, false
+	}
+	return b, true
+}
+
+// decodeBase64 decodes 16 bytes from standard or URL-safe Base64, with or
+// without padding.
+func decodeBase64(s string) ([16]byte, bool) {
+	var b [16]byte
+	var enc *base64.Encoding
+	switch {
+	case len(s) == 22 && strings.ContainsAny(s, "-_"):
+		enc = base64.RawURLEncoding
+	case len(s) == 22:
+		enc = base64.RawStdEncoding
+	case len(s) == 24 && strings.ContainsAny(s, "-_"):
+		enc = base64.URLEncoding
+	case len(s) == 24:
+		enc = base64.StdEncoding
+	default:
+		return b, false
+	}
+	var buf [18]byte
+	n, err := enc.Strict().Decode(buf[:], []byte(s))
+	if err != nil || n != 16 {
+		return b, false
+	}
+	copy(b[:], buf[:16])
+	return b, true
+}
@@ -0,0 +1,90 @@
+package uuid
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAllFormatsRoundTrip(t *testing.T) {
+	for _, u := range append(randomUUIDs(t, 10), "00000000-0000-0000-0000-000000000000") {
+		f, err := UUID(strings.ToUpper(string(u))).AllFormats()
+		if err != nil {
+			t.Fatal(err)
+		}
+		check := func(field string, got UUID) {
+			t.Helper()
+			if got != u {
+				t.Errorf("%s of %s decodes to %s", field, u, got)
+			}
+		}
+		check("Canonical", UUID(f.Canonical))
+		for field, s := range map[string]string{
+			"Compact": f.Compact,
+			"Braced":  f.Braced,
+			"URN":     f.URN,
+		} {
+			b, err := decodeLenient(s)
+			if err != nil {
+				t.Fatalf("%s %q: %v", field, s, err)
+			}
+			check(field, encode(b))
+		}
+		b, ok := decodeBase58(f.Base58)
+		if !ok {
+			t.Fatalf("Base58 %q does not decode", f.Base58)
+		}
+		check("Base58", encode(b))
+		raw, err := base64.RawURLEncoding.DecodeString(f.Base64)
+		if err != nil || len(raw) != 16 {
+			t.Fatalf("Base64 %q: %v", f.Base64, err)
+		}
+		check("Base64", encode([16]byte(raw)))
+		raw, err = base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(f.Base32)
+		if err != nil || len(raw) != 16 {
+			t.Fatalf("Base32 %q: %v", f.Base32, err)
+		}
+		check("Base32", encode([16]byte(raw)))
+		check("Raw", encode(f.Raw))
+	}
+	if _, err := UUID("not-a-uuid").AllFormats(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestDetectEncoding(t *testing.T) {
+	const u = "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d"
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"canonical", u, []string{"canonical"}},
+		{"canonical upper", "6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6D", []string{"canonical"}},
+		{"braced", "{" + u + "}", []string{"braced"}},
+		{"urn", "urn:uuid:" + u, []string{"urn"}},
+		{"urn mixed case", "URN:UUID:" + u, []string{"urn"}},
+		{"compact", "6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d", []string{"compact"}},
+		{"base58", "Eiin6GU1vc6WLa5dmqUmaC", []string{"base58"}},
+		{"base58 leading zero byte", "147HGCcgCYBDaP78r7XvR9", []string{"base58"}},
+		{"base58 nil", "1111111111111111", []string{"base58"}},
+		{"base58 and base64", "GHwH5NVjbpvDxACyckS4zQ", []string{"base58", "base64"}},
+		{"base64 std", "bxoLHI1+SiuMnR4vOktcbQ", []string{"base64"}},
+		{"base64 std padded", "bxoLHI1+SiuMnR4vOktcbQ==", []string{"base64"}},
+		{"base64 url", "bxoLHI1-SiuMnR4vOktcbQ", []string{"base64"}},
+		{"base64 url padded", "bxoLHI1-SiuMnR4vOktcbQ==", []string{"base64"}},
+		{"base64 trailing bits", "bxoLHI1+SiuMnR4vOktcbR", nil},
+		{"base64 padded trailing bits", "bxoLHI1-SiuMnR4vOktcbR==", nil},
+		{"base58 missing leading ones", "12", nil},
+		{"braced without close", "{" + u, nil},
+		{"empty", "", nil},
+		{"garbage", "not-a-uuid", nil},
+	}
+	for _, tt := range tests {
+		if got := DetectEncoding(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: DetectEncoding(%q) = %q, want %q", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
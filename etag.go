@@ -0,0 +1,54 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// weakETagPrefix marks a weak entity tag, as defined by RFC 9110.
+const weakETagPrefix = "W/"
+
+// ETag returns the UUID as an HTTP entity tag: the lowercase canonical form
+// in double quotes, prefixed with "W/" if weak. Canonical UUIDs contain only
+// characters permitted in entity tags, so no escaping is needed.
+//
+// Parameters:
+//   - weak: Whether to return a weak entity tag.
+//
+// Returns:
+//   - string: The entity tag, e.g. "\"<uuid>\"" or "W/\"<uuid>\"".
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) ETag(weak bool) (string, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("ETag: %w", err)
+	}
+	tag := `"` + string(encode(b)) + `"`
+	if weak {
+		tag = weakETagPrefix + tag
+	}
+	return tag, nil
+}
+
+// FromETag parses an entity tag produced by ETag. The weak prefix is
+// case-sensitive, as required by RFC 9110, and the quoted value must be a
+// canonical UUID in either case.
+//
+// Parameters:
+//   - s: The entity tag.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - bool: True if the entity tag is weak.
+//   - error: An error if s is not a quoted UUID entity tag.
+func FromETag(s string) (UUID, bool, error) {
+	tag, weak := strings.CutPrefix(s, weakETagPrefix)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return "", false, fmt.Errorf("FromETag: malformed entity tag: %s", s)
+	}
+	b, err := decode(UUID(tag[1 : len(tag)-1]))
+	if err != nil {
+		return "", false, fmt.Errorf("FromETag: %w", err)
+	}
+	return encode(b), weak, nil
+}
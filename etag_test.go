@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestETagRoundTrip(t *testing.T) {
+	upper := UUID(strings.ToUpper(string(testUUID)))
+	for _, weak := range []bool{false, true} {
+		tag, err := upper.ETag(weak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `"` + string(testUUID) + `"`
+		if weak {
+			want = "W/" + want
+		}
+		if tag != want {
+			t.Errorf("ETag(%v) = %s, want %s", weak, tag, want)
+		}
+		u, gotWeak, err := FromETag(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != testUUID || gotWeak != weak {
+			t.Errorf("FromETag(%s) = %s, %v; want %s, %v", tag, u, gotWeak, testUUID, weak)
+		}
+	}
+}
+
+func TestFromETagErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		`"`,
+		`""`,
+		string(testUUID),
+		`"` + string(testUUID),
+		`W/` + string(testUUID),
+		`w/"` + string(testUUID) + `"`,
+		`"{` + string(testUUID) + `}"`,
+		`"not-a-uuid"`,
+	} {
+		if _, _, err := FromETag(s); err == nil {
+			t.Errorf("FromETag(%q): expected error", s)
+		}
+	}
+	if _, err := UUID("not-a-uuid").ETag(false); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
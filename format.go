@@ -0,0 +1,212 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatWith returns the five 8-4-4-4-12 blocks of the UUID in lowercase,
+// joined by sep. An empty sep yields the 32-character compact form and "-"
+// yields the canonical form.
+//
+// Parameters:
+//   - sep: The separator placed between blocks. It may be any length.
+//
+// Returns:
+//   - string: The formatted UUID.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) FormatWith(sep string) (string, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("FormatWith: %w", err)
+	}
+	c := string(encode(b))
+	return strings.Join(
+		[]string{c[0:8], c[9:13], c[14:18], c[19:23], c[24:36]}, sep,
+	), nil
+}
+
+// Rehyphenate inserts hyphens into a 32-character compact UUID at the
+// canonical 8-4-4-4-12 positions. It performs no validation and allocates
+// only the result, for hot paths where inputs are known to be valid.
+//
+// By design, malformed input is not reported: non-hex characters are copied
+// through as-is, and input that is not exactly 32 bytes long is returned
+// unchanged. Where validity matters, check the result with IsValid or
+// Ver4Var1FromString.
+//
+// Parameters:
+//   - compact: The 32 hex characters of a UUID.
+//
+// Returns:
+//   - UUID: The hyphenated UUID.
+func Rehyphenate(compact string) UUID {
+	if len(compact) != 32 {
+		return UUID(compact)
+	}
+	var buf [36]byte
+	copy(buf[0:8], compact[0:8])
+	buf[8] = '-'
+	copy(buf[9:13], compact[8:12])
+	buf[13] = '-'
+	copy(buf[14:18], compact[12:16])
+	buf[18] = '-'
+	copy(buf[19:23], compact[16:20])
+	buf[23] = '-'
+	copy(buf[24:36], compact[20:32])
+	return UUID(buf[:])
+}
+
+// FixHyphens repairs a UUID whose 32 hex digits are intact but whose hyphens
+// are misplaced, missing or doubled: it strips every hyphen, checks that
+// exactly 32 hex digits remain with an RFC 9562 version nibble (1 to 8) and
+// Variant 1 bits, and returns the digits hyphenated at the canonical
+// 8-4-4-4-12 positions. Other characters, such as braces or a URN prefix,
+// are not stripped and make the input invalid.
+//
+// Parameters:
+//   - s: The string to repair.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if s does not hold exactly 32 hex digits besides
+//     hyphens or its version or variant bits are invalid.
+func FixHyphens(s string) (UUID, error) {
+	b, err := decodeCompact(strings.ReplaceAll(s, "-", ""))
+	if err != nil {
+		return "", fmt.Errorf("FixHyphens: %w", err)
+	}
+	if err := checkRFCLayout(b); err != nil {
+		return "", fmt.Errorf("FixHyphens: %w: %s", err, s)
+	}
+	return encode(b), nil
+}
+
+// FromHalvesHex joins the two 64-bit halves of a UUID transmitted as
+// separate 16-digit hex fields, as in some legacy wire formats, and checks
+// the result like FixHyphens: it must have an RFC 9562 version nibble (1 to
+// 8) and Variant 1 bits.
+//
+// Parameters:
+//   - hiHex: The 16 hex digits of the first 8 bytes.
+//   - loHex: The 16 hex digits of the last 8 bytes.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if either half is not 16 hex digits or the version or
+//     variant bits are invalid.
+func FromHalvesHex(hiHex, loHex string) (UUID, error) {
+	if len(hiHex) != 16 || len(loHex) != 16 {
+		return "", fmt.Errorf(
+			"FromHalvesHex: expected 16 hex digits per half: %q, %q", hiHex, loHex,
+		)
+	}
+	b, err := decodeCompact(hiHex + loHex)
+	if err != nil {
+		return "", fmt.Errorf("FromHalvesHex: %w", err)
+	}
+	if err := checkRFCLayout(b); err != nil {
+		return "", fmt.Errorf("FromHalvesHex: %w: %s%s", err, hiHex, loHex)
+	}
+	return encode(b), nil
+}
+
+// HalvesHex splits the UUID into the 16 lowercase hex digits of each of its
+// 64-bit halves, the reverse of FromHalvesHex.
+//
+// Returns:
+//   - hi: The hex digits of the first 8 bytes.
+//   - lo: The hex digits of the last 8 bytes.
+//   - err: An error if the receiver is not a valid UUID.
+func (u UUID) HalvesHex() (hi, lo string, err error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", "", fmt.Errorf("HalvesHex: %w", err)
+	}
+	return hex.EncodeToString(b[:8]), hex.EncodeToString(b[8:]), nil
+}
+
+// checkRFCLayout reports an error unless b has an RFC 9562 version nibble (1
+// to 8) and Variant 1 bits.
+func checkRFCLayout(b [16]byte) error {
+	if v := b[6] >> 4; v < 1 || v > 8 {
+		return fmt.Errorf("invalid version %d", v)
+	}
+	if b[8]&0xc0 != 0x80 {
+		return errors.New("invalid variant")
+	}
+	return nil
+}
+
+// Grouped splits the 32 lowercase hex digits of the UUID into groups of
+// groupSize characters joined by sep, e.g. groups of 4 joined by "." give
+// "1234.5678.9abc...". If groupSize does not divide 32, the final group
+// holds the remaining digits and is shorter than the others. A groupSize of
+// 32 or more yields the compact form.
+//
+// Parameters:
+//   - groupSize: The number of hex digits per group. Must be positive.
+//   - sep: The separator placed between groups.
+//
+// Returns:
+//   - string: The grouped UUID.
+//   - error: An error if groupSize is not positive or the receiver is not a
+//     valid UUID.
+func (u UUID) Grouped(groupSize int, sep string) (string, error) {
+	if groupSize <= 0 {
+		return "", fmt.Errorf("Grouped: group size must be positive: %d", groupSize)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("Grouped: %w", err)
+	}
+	c := hex.EncodeToString(b[:])
+	groups := make([]string, 0, (len(c)+groupSize-1)/groupSize)
+	for i := 0; i < len(c); i += groupSize {
+		groups = append(groups, c[i:min(i+groupSize, len(c))])
+	}
+	return strings.Join(groups, sep), nil
+}
+
+// FileToken returns the UUID as 32 lowercase hex digits without hyphens,
+// which is safe as a file name on all common filesystems. The output only
+// ever contains [0-9a-f] and is always 32 characters long.
+//
+// Returns:
+//   - string: The filesystem-safe token.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) FileToken() (string, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("FileToken: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// DisplayTag returns a short, version-aware tag for display, of the form
+// "v<version>.<suffix>", e.g. "v4.3f9a". The suffix is the big-endian value
+// of the first 4 bytes reduced modulo 36^4 and written as 4 zero-padded
+// lowercase base36 digits. The same UUID always yields the same tag.
+//
+// With about 1.7 million suffixes, two random UUIDs of the same version
+// share a tag with probability about 1 in 1.7 million, and some pair among
+// about 1,500 collides with probability 1/2, so tags suit glancing, not
+// identification. The first bytes of Version 6 and 7 UUIDs hold their
+// timestamp, so UUIDs minted close together share tags far more often.
+//
+// Returns:
+//   - string: The display tag.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) DisplayTag() (string, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("DisplayTag: %w", err)
+	}
+	const space = 36 * 36 * 36 * 36
+	suffix := strconv.FormatUint(uint64(binary.BigEndian.Uint32(b[:4])%space), 36)
+	return fmt.Sprintf("v%d.%04s", b[6]>>4, suffix), nil
+}
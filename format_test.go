@@ -0,0 +1,236 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatWith(t *testing.T) {
+	tests := []struct {
+		sep  string
+		want string
+	}{
+		{"-", "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d"},
+		{"", "6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d"},
+		{"_", "6f1a0b1c_8d7e_4a2b_8c9d_1e2f3a4b5c6d"},
+		{" :: ", "6f1a0b1c :: 8d7e :: 4a2b :: 8c9d :: 1e2f3a4b5c6d"},
+	}
+	for _, tt := range tests {
+		got, err := UUID("6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6D").FormatWith(tt.sep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("FormatWith(%q) = %q, want %q", tt.sep, got, tt.want)
+		}
+	}
+	if _, err := UUID("bad").FormatWith("-"); err == nil {
+		t.Error("FormatWith on invalid UUID: expected error")
+	}
+}
+
+func TestRehyphenate(t *testing.T) {
+	if got := Rehyphenate("6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d"); got != testUUID {
+		t.Errorf("got %s, want %s", got, testUUID)
+	}
+	if got := Rehyphenate("short"); got != "short" {
+		t.Errorf("short input: got %s, want it unchanged", got)
+	}
+}
+
+func BenchmarkRehyphenate(b *testing.B) {
+	for b.Loop() {
+		_ = Rehyphenate("6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d")
+	}
+}
+
+// BenchmarkDecodeCompact measures the validating compact path Rehyphenate
+// skips: hex decoding followed by canonical encoding.
+func BenchmarkDecodeCompact(b *testing.B) {
+	for b.Loop() {
+		u, err := decodeCompact("6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d")
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = encode(u)
+	}
+}
+
+func TestGrouped(t *testing.T) {
+	tests := []struct {
+		size int
+		sep  string
+		want string
+	}{
+		{2, ":", "6f:1a:0b:1c:8d:7e:4a:2b:8c:9d:1e:2f:3a:4b:5c:6d"},
+		{4, ".", "6f1a.0b1c.8d7e.4a2b.8c9d.1e2f.3a4b.5c6d"},
+		{8, "-", "6f1a0b1c-8d7e4a2b-8c9d1e2f-3a4b5c6d"},
+		{5, " ", "6f1a0 b1c8d 7e4a2 b8c9d 1e2f3 a4b5c 6d"},
+		{32, ".", "6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d"},
+		{64, ".", "6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d"},
+	}
+	for _, tt := range tests {
+		got, err := testUUID.Grouped(tt.size, tt.sep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Grouped(%d, %q) = %q, want %q", tt.size, tt.sep, got, tt.want)
+		}
+	}
+}
+
+func TestGroupedErrors(t *testing.T) {
+	for _, size := range []int{0, -4} {
+		if _, err := testUUID.Grouped(size, "."); err == nil {
+			t.Errorf("Grouped(%d): expected error", size)
+		}
+	}
+	if _, err := UUID("not-a-uuid").Grouped(4, "."); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestFileToken(t *testing.T) {
+	inputs := append(randomUUIDs(t, 20), UUID(strings.ToUpper(string(testUUID))))
+	for _, u := range inputs {
+		got, err := u.FileToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 32 {
+			t.Errorf("FileToken(%q) = %q, want 32 characters", u, got)
+		}
+		if strings.Trim(got, "0123456789abcdef") != "" {
+			t.Errorf("FileToken(%q) = %q contains characters outside [0-9a-f]", u, got)
+		}
+	}
+	got, err := testUUID.FileToken()
+	if want := strings.ReplaceAll(string(testUUID), "-", ""); err != nil || got != want {
+		t.Errorf("FileToken() = %q, %v; want %q", got, err, want)
+	}
+	for _, u := range []UUID{"", "not-a-uuid", "../../etc/passwd"} {
+		if got, err := u.FileToken(); err == nil {
+			t.Errorf("FileToken(%q) = %q, want error", u, got)
+		}
+	}
+}
+
+func TestFixHyphens(t *testing.T) {
+	for _, s := range []string{
+		string(testUUID),
+		"6f1a0b1c8d7e4a2b8c9d1e2f3a4b5c6d",
+		"6f1a-0b1c-8d7e-4a2b-8c9d-1e2f-3a4b-5c6d",
+		"6f1a0b1c8d7e-4a2b8c9d-1e2f3a4b5c6d",
+		"-6f1a0b1c--8d7e-4a2b-8c9d-1e2f3a4b5c6d-",
+		strings.ToUpper("6f1a0b1c8d7e4a2b-8c9d-1e2f-3a4b5c6d"),
+	} {
+		got, err := FixHyphens(s)
+		if err != nil {
+			t.Fatalf("FixHyphens(%q): %v", s, err)
+		}
+		if got != testUUID {
+			t.Errorf("FixHyphens(%q) = %s, want %s", s, got, testUUID)
+		}
+	}
+}
+
+func TestFixHyphensErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6",
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d0",
+		"{6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d}",
+		"6f1a0b1c-8d7e-0a2b-8c9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-4a2b-cc9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5cXX",
+	} {
+		if _, err := FixHyphens(s); err == nil {
+			t.Errorf("FixHyphens(%q): expected error", s)
+		}
+	}
+}
+
+func TestDisplayTag(t *testing.T) {
+	tests := []struct {
+		u    UUID
+		want string
+	}{
+		{testUUID, "v4.rifw"},
+		{"00000001-0000-4000-8000-000000000000", "v4.0001"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "v1.c55c"},
+		{"2ed6657d-e927-568b-95e1-2665a8aea6a2", "v5.uh6l"},
+	}
+	for _, tt := range tests {
+		got, err := tt.u.DisplayTag()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s.DisplayTag() = %q, want %q", tt.u, got, tt.want)
+		}
+	}
+	v7 := v7At(t, time.Now())
+	tag, err := v7.DisplayTag()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := v7.DisplayTag(); again != tag || len(tag) != 7 || tag[:3] != "v7." {
+		t.Errorf("v7 DisplayTag() = %q then %q, want a stable v7 tag", tag, again)
+	}
+	if _, err := UUID("not-a-uuid").DisplayTag(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestHalvesHexRoundTrip(t *testing.T) {
+	hi, lo, err := UUID(strings.ToUpper(string(testUUID))).HalvesHex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hi != "6f1a0b1c8d7e4a2b" || lo != "8c9d1e2f3a4b5c6d" {
+		t.Errorf("HalvesHex() = %q, %q", hi, lo)
+	}
+	for _, u := range append(randomUUIDs(t, 50), testUUID) {
+		hi, lo, err := u.HalvesHex()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := FromHalvesHex(hi, lo)
+		if err != nil || got != u {
+			t.Fatalf("FromHalvesHex(%q, %q) = %s, %v; want %s", hi, lo, got, err, u)
+		}
+	}
+	got, err := FromHalvesHex("6F1A0B1C8D7E4A2B", "8C9D1E2F3A4B5C6D")
+	if err != nil || got != testUUID {
+		t.Errorf("FromHalvesHex() uppercase = %s, %v; want %s", got, err, testUUID)
+	}
+}
+
+func TestFromHalvesHexMalformed(t *testing.T) {
+	const hi, lo = "6f1a0b1c8d7e4a2b", "8c9d1e2f3a4b5c6d"
+	tests := []struct {
+		name   string
+		hi, lo string
+	}{
+		{"short high half", hi[:15], lo},
+		{"long low half", hi, lo + "0"},
+		{"swapped lengths", hi + "8c", lo[2:]},
+		{"empty", "", ""},
+		{"non-hex", "6f1a0b1c8d7e4a2g", lo},
+		{"hyphenated", "6f1a0b1c-8d7e-4a", lo},
+		{"version 0", "6f1a0b1c8d7e0a2b", lo},
+		{"version 9", "6f1a0b1c8d7e9a2b", lo},
+		{"variant 0", hi, "0c9d1e2f3a4b5c6d"},
+		{"variant 2", hi, "cc9d1e2f3a4b5c6d"},
+	}
+	for _, tt := range tests {
+		if got, err := FromHalvesHex(tt.hi, tt.lo); err == nil {
+			t.Errorf("%s: FromHalvesHex(%q, %q) = %s, want error", tt.name, tt.hi, tt.lo, got)
+		}
+	}
+	if _, _, err := UUID("not-a-uuid").HalvesHex(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
@@ -0,0 +1,130 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrVanityNotFound is returned by VanityBase58 when no UUID with the
+// requested prefix was found within the attempt limit.
+var ErrVanityNotFound = errors.New("vanity prefix not found")
+
+// ErrPredicateUnsatisfied is returned by Ver4Where when no generated UUID
+// satisfied the predicate within the attempt limit.
+var ErrPredicateUnsatisfied = errors.New("predicate unsatisfied")
+
+// Ver4MultiSource generates a Version 4, Variant 1 UUID from several
+// entropy sources. Exactly 16 bytes are read from each reader and the
+// results are XORed together before the version and variant bits are set,
+// so the output is unpredictable as long as any one source is. With a
+// single reader it is equivalent to generating from that reader alone.
+//
+// Parameters:
+//   - readers: The entropy sources. At least one is required.
+//
+// Returns:
+//   - UUID: A random UUID conforming to Version 4 and Variant 1.
+//   - error: An error if no reader is given or any reader fails or returns
+//     fewer than 16 bytes.
+func Ver4MultiSource(readers ...io.Reader) (UUID, error) {
+	if len(readers) == 0 {
+		return "", errors.New("Ver4MultiSource: no entropy sources")
+	}
+	var b [16]byte
+	for i, r := range readers {
+		var chunk [16]byte
+		if _, err := io.ReadFull(r, chunk[:]); err != nil {
+			return "", fmt.Errorf("Ver4MultiSource: source %d: %w", i, err)
+		}
+		for j := range b {
+			b[j] ^= chunk[j]
+		}
+	}
+	setVer4Var1(&b)
+	return encode(b), nil
+}
+
+// VanityBase58 generates random Version 4, Variant 1 UUIDs until one whose
+// Bitcoin Base58 encoding, as used by PrefixedID, starts with prefix.
+//
+// The expected number of attempts grows exponentially: a prefix of k
+// characters takes about c × 58^(k-1) attempts, where c depends on its
+// first character. Most UUIDs encode to 22 characters led by "2" to "Y", so
+// c is about 31 for "2" to "X" and 50 for "Y". A leading "1" stands for a
+// zero first byte, so c is 256. "Z" and the lowercase letters can only lead
+// the rarer 21-character encodings, so c is about 1,830. For example, "A"
+// takes about 31 attempts and "a" about 1,830, while "Abc" takes about
+// 104,000 and "abc" about 6.2 million.
+//
+// Parameters:
+//   - prefix: The desired prefix, using only Base58 characters.
+//   - maxAttempts: The maximum number of UUIDs to try. Must be positive.
+//
+// Returns:
+//   - UUID: The first UUID found with the prefix.
+//   - error: An error wrapping ErrVanityNotFound if no UUID was found, or an
+//     error if the arguments are invalid or crypto/rand fails.
+func VanityBase58(prefix string, maxAttempts int) (UUID, error) {
+	for i := 0; i < len(prefix); i++ {
+		if strings.IndexByte(base58Alphabet, prefix[i]) < 0 {
+			return "", fmt.Errorf("VanityBase58: invalid Base58 prefix: %q", prefix)
+		}
+	}
+	if maxAttempts <= 0 {
+		return "", fmt.Errorf(
+			"VanityBase58: max attempts must be positive: %d", maxAttempts,
+		)
+	}
+	for range maxAttempts {
+		b, err := random16()
+		if err != nil {
+			return "", fmt.Errorf("VanityBase58: %w", err)
+		}
+		setVer4Var1(&b)
+		if strings.HasPrefix(encodeBase58(b), prefix) {
+			return encode(b), nil
+		}
+	}
+	return "", fmt.Errorf(
+		"VanityBase58: %w: %q after %d attempts", ErrVanityNotFound,
+		prefix, maxAttempts,
+	)
+}
+
+// Ver4Where generates random Version 4, Variant 1 UUIDs in lowercase
+// canonical form until pred returns true for one of them. It generalizes
+// constrained generation such as leading zeros or shard ranges; the
+// expected number of attempts is the inverse of the fraction of UUIDs
+// satisfying pred.
+//
+// Parameters:
+//   - pred: The property the UUID must have.
+//   - maxAttempts: The maximum number of UUIDs to try. Must be positive.
+//
+// Returns:
+//   - UUID: The first UUID satisfying pred.
+//   - error: An error wrapping ErrPredicateUnsatisfied if no UUID was found,
+//     or an error if maxAttempts is not positive or crypto/rand fails.
+func Ver4Where(pred func(UUID) bool, maxAttempts int) (UUID, error) {
+	if maxAttempts <= 0 {
+		return "", fmt.Errorf(
+			"Ver4Where: max attempts must be positive: %d", maxAttempts,
+		)
+	}
+	for range maxAttempts {
+		b, err := random16()
+		if err != nil {
+			return "", fmt.Errorf("Ver4Where: %w", err)
+		}
+		setVer4Var1(&b)
+		u := encode(b)
+		if pred(u) {
+			return u, nil
+		}
+	}
+	return "", fmt.Errorf(
+		"Ver4Where: %w after %d attempts", ErrPredicateUnsatisfied, maxAttempts,
+	)
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestVer4MultiSource(t *testing.T) {
+	a := bytes.Repeat([]byte{0x0f}, 16)
+	b := []byte{
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+	got, err := Ver4MultiSource(bytes.NewReader(a), bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("0f1e2d3c-4b5a-4978-8796-a5b4c3d2e1f0"); got != want {
+		t.Errorf("Ver4MultiSource() = %s, want %s", got, want)
+	}
+	single, err := Ver4MultiSource(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want [16]byte
+	copy(want[:], b)
+	setVer4Var1(&want)
+	if single != encode(want) {
+		t.Errorf("Ver4MultiSource() with one reader = %s, want %s", single, encode(want))
+	}
+}
+
+func TestVer4MultiSourceErrors(t *testing.T) {
+	if _, err := Ver4MultiSource(); err == nil {
+		t.Error("no readers: expected error")
+	}
+	full := bytes.NewReader(make([]byte, 16))
+	_, err := Ver4MultiSource(full, bytes.NewReader(make([]byte, 15)))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("short read: error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestVanityBase58(t *testing.T) {
+	// "A" is expected after about 31 attempts and "a" after about 1,830.
+	for _, prefix := range []string{"A", "7", "a"} {
+		u, err := VanityBase58(prefix, 100000)
+		if err != nil {
+			t.Fatalf("VanityBase58(%q): %v", prefix, err)
+		}
+		if !IsValid(string(u)) {
+			t.Errorf("VanityBase58(%q) = %s, want a valid v4 UUID", prefix, u)
+		}
+		b, _ := decode(u)
+		if s := encodeBase58(b); !strings.HasPrefix(s, prefix) {
+			t.Errorf("VanityBase58(%q) = %s with Base58 %q", prefix, u, s)
+		}
+	}
+}
+
+func TestVanityBase58Errors(t *testing.T) {
+	_, err := VanityBase58("zzzzzzzz", 10)
+	if !errors.Is(err, ErrVanityNotFound) {
+		t.Errorf("unreachable prefix: error = %v, want ErrVanityNotFound", err)
+	}
+	for _, prefix := range []string{"0", "O", "I", "l", "A-"} {
+		if _, err := VanityBase58(prefix, 10); err == nil || errors.Is(err, ErrVanityNotFound) {
+			t.Errorf("VanityBase58(%q) error = %v, want an invalid prefix error", prefix, err)
+		}
+	}
+	if _, err := VanityBase58("A", 0); err == nil {
+		t.Error("zero max attempts: expected error")
+	}
+}
+
+func TestVer4Where(t *testing.T) {
+	pred := func(u UUID) bool { return strings.HasPrefix(string(u), "0") }
+	for range 10 {
+		u, err := Ver4Where(pred, 10000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !pred(u) || !IsValid(string(u)) {
+			t.Errorf("Ver4Where() = %s, want a valid v4 UUID starting with 0", u)
+		}
+	}
+	// The variant digit is one of 8, 9, a or b; the predicate sees it in
+	// lowercase.
+	variant := func(u UUID) bool { return u[19] == 'b' }
+	if _, err := Ver4Where(variant, 10000); err != nil {
+		t.Errorf("lowercase variant digit: %v", err)
+	}
+}
+
+func TestVer4WhereUnsatisfiable(t *testing.T) {
+	calls := 0
+	never := func(UUID) bool { calls++; return false }
+	_, err := Ver4Where(never, 50)
+	if !errors.Is(err, ErrPredicateUnsatisfied) {
+		t.Errorf("error = %v, want ErrPredicateUnsatisfied", err)
+	}
+	if calls != 50 {
+		t.Errorf("predicate called %d times, want 50", calls)
+	}
+	if _, err := Ver4Where(never, 0); err == nil || errors.Is(err, ErrPredicateUnsatisfied) {
+		t.Errorf("zero max attempts: error = %v, want an argument error", err)
+	}
+}
@@ -2,4 +2,7 @@ module github.com/aatuh/uuid
 
 go 1.25.1
 
-require github.com/aatuh/randutil v1.0.2
+require (
+	github.com/aatuh/randutil v1.0.2
+	golang.org/x/text v0.29.0
+)
@@ -0,0 +1,221 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// Seed64 derives a stable int64 from the UUID, suitable for seeding a
+// math/rand source so that each UUID always yields the same pseudo-random
+// sequence. The seed is the first 8 bytes (big-endian) of the SHA-256 digest
+// of the UUID's 16 bytes, so time-ordered UUIDs sharing leading bytes still
+// get unrelated seeds.
+//
+// The seed is not a secret and must not be used for anything
+// cryptographic.
+//
+// Returns:
+//   - int64: The derived seed.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Seed64() (int64, error) {
+	h, err := hash64(u)
+	if err != nil {
+		return 0, fmt.Errorf("Seed64: %w", err)
+	}
+	return int64(h), nil
+}
+
+// hash64 returns the first 8 bytes (big-endian) of the SHA-256 digest of the
+// UUID's 16 bytes.
+func hash64(u UUID) (uint64, error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, err
+	}
+	sum := sha256.Sum256(b[:])
+	return binary.BigEndian.Uint64(sum[:8]), nil
+}
+
+// labeledHash64 is like hash64 but derives the value with expand under
+// label, so that features hashing the same UUID get independent values.
+func labeledHash64(u UUID, label string) (uint64, error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(expand(b, label, 8)), nil
+}
+
+// HashBucket returns the bucket the UUID falls into in a hash table of n
+// buckets. The hash is the same SHA-256-derived 64-bit value used by Seed64,
+// reduced modulo n; the modulo bias is negligible for any practical n.
+//
+// Parameters:
+//   - n: The number of buckets.
+//
+// Returns:
+//   - uint64: The bucket index in [0, n).
+//   - error: An error if n is not positive or the receiver is not a valid
+//     UUID.
+func (u UUID) HashBucket(n int) (uint64, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("HashBucket: bucket count must be positive: %d", n)
+	}
+	h, err := hash64(u)
+	if err != nil {
+		return 0, fmt.Errorf("HashBucket: %w", err)
+	}
+	return h % uint64(n), nil
+}
+
+// CollisionRate places us into a hash table of n buckets using HashBucket
+// and reports the fraction of the n buckets that hold more than one entry.
+//
+// Parameters:
+//   - us: The UUIDs to place.
+//   - n: The number of buckets.
+//
+// Returns:
+//   - float64: The fraction of buckets with a collision, in [0, 1].
+//   - error: An error if n is not positive or us contains an invalid UUID.
+func CollisionRate(us []UUID, n int) (float64, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf(
+			"CollisionRate: bucket count must be positive: %d", n,
+		)
+	}
+	counts := make(map[uint64]int)
+	collided := 0
+	for i, u := range us {
+		bucket, err := u.HashBucket(n)
+		if err != nil {
+			return 0, fmt.Errorf("CollisionRate: element %d: %w", i, err)
+		}
+		counts[bucket]++
+		if counts[bucket] == 2 {
+			collided++
+		}
+	}
+	return float64(collided) / float64(n), nil
+}
+
+// RingPosition returns the UUID's position on a 64-bit consistent-hashing
+// ring. The position hashes all 16 bytes with SHA-256 rather than truncating
+// them, so UUIDs that share a prefix, such as time-ordered ones, still
+// spread uniformly around the ring.
+//
+// Returns:
+//   - uint64: The ring position.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) RingPosition() (uint64, error) {
+	h, err := hash64(u)
+	if err != nil {
+		return 0, fmt.Errorf("RingPosition: %w", err)
+	}
+	return h, nil
+}
+
+// RolloutBucket gives a stable per-entity on/off decision for a percentage
+// rollout. The UUID is assigned to one of 100 buckets exactly as by
+// HashBucket(100), and the result is true if that bucket is below percent.
+// Because the bucket does not depend on percent, rollouts are monotone: a
+// UUID that is on at some percentage stays on at every higher one.
+//
+// Parameters:
+//   - percent: The share of UUIDs to enable, in [0, 100].
+//
+// Returns:
+//   - bool: True if the UUID is in the enabled share.
+//   - error: An error if percent is out of range or the receiver is not a
+//     valid UUID.
+func (u UUID) RolloutBucket(percent int) (bool, error) {
+	if percent < 0 || percent > 100 {
+		return false, fmt.Errorf(
+			"RolloutBucket: percent must be in [0, 100]: %d", percent,
+		)
+	}
+	h, err := hash64(u)
+	if err != nil {
+		return false, fmt.Errorf("RolloutBucket: %w", err)
+	}
+	return h%100 < uint64(percent), nil
+}
+
+// Jitter deterministically maps the UUID to an offset within window, for
+// spreading scheduled jobs so that each entity's job fires at a stable but
+// distributed time. The offset scales a 64-bit hash h of the UUID to the
+// window, i.e. floor(h × window / 2^64), so offsets are spread evenly
+// across the window. h is SHA-256 over the bytes and the label "jitter",
+// so offsets are independent of Seed64, HashBucket and the other hashes
+// of the same UUID.
+//
+// Parameters:
+//   - window: The length of the window. Must be positive.
+//
+// Returns:
+//   - time.Duration: The offset, in [0, window).
+//   - error: An error if window is not positive or the receiver is not a
+//     valid UUID.
+func (u UUID) Jitter(window time.Duration) (time.Duration, error) {
+	if window <= 0 {
+		return 0, fmt.Errorf("Jitter: window must be positive: %s", window)
+	}
+	h, err := labeledHash64(u, "jitter")
+	if err != nil {
+		return 0, fmt.Errorf("Jitter: %w", err)
+	}
+	offset, _ := bits.Mul64(h, uint64(window))
+	return time.Duration(offset), nil
+}
+
+// Weight deterministically maps the UUID to a weight in [1, maxWeight], for
+// weighted sampling experiments without a separate weight column. Like
+// Jitter, it scales a labeled 64-bit hash h to the range, i.e.
+// 1 + floor(h × maxWeight / 2^64), so weights are uniform up to a relative
+// bias below 2^-32. The label is "weight", so an entity's weight tells
+// nothing about its jitter offset, rollout bucket or ring position.
+//
+// Parameters:
+//   - maxWeight: The largest weight. Must be positive.
+//
+// Returns:
+//   - uint32: The weight, in [1, maxWeight].
+//   - error: An error if maxWeight is 0 or the receiver is not a valid UUID.
+func (u UUID) Weight(maxWeight uint32) (uint32, error) {
+	if maxWeight == 0 {
+		return 0, errors.New("Weight: max weight must be positive")
+	}
+	h, err := labeledHash64(u, "weight")
+	if err != nil {
+		return 0, fmt.Errorf("Weight: %w", err)
+	}
+	w, _ := bits.Mul64(h, uint64(maxWeight))
+	return uint32(w) + 1, nil
+}
+
+// LogTag returns a short, stable tag for correlating log lines by eye: the
+// first 6 characters of the standard Base32 encoding of the SHA-256-derived
+// hash used by Seed64, i.e. 30 bits. The same UUID always yields the same
+// tag, and invalid input yields the placeholder "------" instead of an
+// error.
+//
+// Tags are for visual grouping only. With 2^30 possible values, a given
+// pair of UUIDs collides with probability about 1 in a billion, but among
+// about 38,000 UUIDs some pair collides with probability 1/2.
+//
+// Returns:
+//   - string: The 6-character tag.
+func (u UUID) LogTag() string {
+	h, err := hash64(u)
+	if err != nil {
+		return "------"
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], h)
+	return base32.StdEncoding.EncodeToString(b[:4])[:6]
+}
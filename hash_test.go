@@ -0,0 +1,342 @@
+package uuid
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSeed64Deterministic(t *testing.T) {
+	a, err := testUUID.Seed64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := testUUID.Seed64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatalf("Seed64 not deterministic: %d != %d", a, b)
+	}
+	r1, r2 := rand.New(rand.NewSource(a)), rand.New(rand.NewSource(b))
+	for range 10 {
+		if x, y := r1.Int63(), r2.Int63(); x != y {
+			t.Fatalf("sequences diverge: %d != %d", x, y)
+		}
+	}
+}
+
+func TestSeed64DistinctForSimilarUUIDs(t *testing.T) {
+	a, _ := UUID("00000000-0000-4000-8000-000000000000").Seed64()
+	b, _ := UUID("00000000-0000-4000-8000-000000000001").Seed64()
+	if a == b {
+		t.Errorf("UUIDs differing in the last bit share seed %d", a)
+	}
+}
+
+func TestHashBucketDistribution(t *testing.T) {
+	const n, samples = 16, 16000
+	counts := make([]int, n)
+	for _, u := range randomUUIDs(t, samples) {
+		b, err := u.HashBucket(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b >= n {
+			t.Fatalf("bucket %d out of range", b)
+		}
+		counts[b]++
+	}
+	// Each bucket expects 1000 entries with a standard deviation of about
+	// 31, so 800-1200 fails only on a broken hash.
+	for i, c := range counts {
+		if c < 800 || c > 1200 {
+			t.Errorf("bucket %d has %d entries, want about %d", i, c, samples/n)
+		}
+	}
+}
+
+func TestHashBucketErrors(t *testing.T) {
+	if _, err := testUUID.HashBucket(0); err == nil {
+		t.Error("HashBucket(0): expected error")
+	}
+	if _, err := UUID("bad").HashBucket(4); err == nil {
+		t.Error("HashBucket on invalid UUID: expected error")
+	}
+}
+
+func TestCollisionRate(t *testing.T) {
+	rate, err := CollisionRate([]UUID{testUUID, testUUID}, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 1.0/8 {
+		t.Errorf("duplicate UUID: got rate %v, want 1/8", rate)
+	}
+	rate, err = CollisionRate(randomUUIDs(t, 100), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate > 0.0001 {
+		t.Errorf("sparse table: got rate %v, want near 0", rate)
+	}
+	if _, err := CollisionRate(nil, 0); err == nil {
+		t.Error("CollisionRate with 0 buckets: expected error")
+	}
+}
+
+func TestRingPositionDistribution(t *testing.T) {
+	const n, buckets = 4000, 16
+	now := time.Now()
+	sets := map[string][]UUID{
+		"random v4": randomUUIDs(t, n),
+		"shared prefix": func() []UUID {
+			us := make([]UUID, n)
+			for i := range us {
+				us[i] = v7At(t, now)
+			}
+			return us
+		}(),
+	}
+	for name, us := range sets {
+		var counts [buckets]int
+		for _, u := range us {
+			p, err := u.RingPosition()
+			if err != nil {
+				t.Fatal(err)
+			}
+			counts[p>>60]++
+		}
+		// Each bucket expects 250 with a standard deviation of about 15.
+		for i, c := range counts {
+			if c < 150 || c > 350 {
+				t.Errorf("%s: bucket %d has %d of %d positions: %v", name, i, c, n, counts)
+				break
+			}
+		}
+	}
+	if _, err := UUID("not-a-uuid").RingPosition(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestLogTag(t *testing.T) {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	tags := make(map[string]bool)
+	us := randomUUIDs(t, 200)
+	for _, u := range us {
+		tag := u.LogTag()
+		if len(tag) != 6 || strings.Trim(tag, alphabet) != "" {
+			t.Fatalf("LogTag(%s) = %q, want 6 Base32 characters", u, tag)
+		}
+		if again := UUID(strings.ToUpper(string(u))).LogTag(); again != tag {
+			t.Errorf("LogTag(%s) not stable: %q != %q", u, again, tag)
+		}
+		tags[tag] = true
+	}
+	// 200 tags out of 2^30 collide with probability about 2 in 100,000.
+	if len(tags) != len(us) {
+		t.Errorf("%d distinct tags for %d UUIDs", len(tags), len(us))
+	}
+	if got := UUID("not-a-uuid").LogTag(); got != "------" {
+		t.Errorf("invalid UUID: LogTag() = %q, want the placeholder", got)
+	}
+}
+
+func TestRolloutBucketMonotone(t *testing.T) {
+	on := make([]int, 101)
+	for _, u := range randomUUIDs(t, 1000) {
+		prev := false
+		for p := 0; p <= 100; p++ {
+			got, err := u.RolloutBucket(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again, _ := u.RolloutBucket(p); again != got {
+				t.Fatalf("RolloutBucket(%d) not stable for %s", p, u)
+			}
+			if prev && !got {
+				t.Fatalf("%s is on at %d%% but off at %d%%", u, p-1, p)
+			}
+			if got {
+				on[p]++
+			}
+			prev = got
+		}
+	}
+	if on[0] != 0 || on[100] != 1000 {
+		t.Errorf("0%% enabled %d and 100%% enabled %d, want 0 and 1000", on[0], on[100])
+	}
+	if on[50] < 400 || on[50] > 600 {
+		t.Errorf("50%% enabled %d of 1000", on[50])
+	}
+}
+
+func TestRolloutBucketErrors(t *testing.T) {
+	for _, p := range []int{-1, 101} {
+		if _, err := testUUID.RolloutBucket(p); err == nil {
+			t.Errorf("RolloutBucket(%d): expected error", p)
+		}
+	}
+	if _, err := UUID("not-a-uuid").RolloutBucket(50); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestJitterDistribution(t *testing.T) {
+	const n, buckets = 4000, 10
+	window := time.Hour
+	var counts [buckets]int
+	for _, u := range randomUUIDs(t, n) {
+		j, err := u.Jitter(window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j < 0 || j >= window {
+			t.Fatalf("Jitter(%s) = %s, outside [0, %s)", window, j, window)
+		}
+		if again, _ := u.Jitter(window); again != j {
+			t.Fatalf("Jitter(%s) not stable for %s", window, u)
+		}
+		counts[j*buckets/window]++
+	}
+	// Each slot expects 400 with a standard deviation of about 19.
+	for i, c := range counts {
+		if c < 300 || c > 500 {
+			t.Errorf("slot %d holds %d of %d offsets: %v", i, c, n, counts)
+			break
+		}
+	}
+}
+
+func TestJitterErrors(t *testing.T) {
+	for _, w := range []time.Duration{0, -time.Second} {
+		if _, err := testUUID.Jitter(w); err == nil {
+			t.Errorf("Jitter(%s): expected error", w)
+		}
+	}
+	if _, err := UUID("not-a-uuid").Jitter(time.Minute); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+	if j, err := testUUID.Jitter(1); err != nil || j != 0 {
+		t.Errorf("Jitter(1ns) = %s, %v; want 0", j, err)
+	}
+}
+
+func TestWeightUniform(t *testing.T) {
+	const n, maxWeight = 5000, 5
+	var counts [maxWeight + 1]int
+	for _, u := range randomUUIDs(t, n) {
+		w, err := u.Weight(maxWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w < 1 || w > maxWeight {
+			t.Fatalf("Weight(%d) = %d, outside [1, %d]", maxWeight, w, maxWeight)
+		}
+		upper := UUID(strings.ToUpper(string(u)))
+		if again, _ := upper.Weight(maxWeight); again != w {
+			t.Fatalf("Weight(%d) not stable for %s: %d != %d", maxWeight, u, again, w)
+		}
+		counts[w]++
+	}
+	// Each weight expects 1000 with a standard deviation of about 28.
+	for w := 1; w <= maxWeight; w++ {
+		if c := counts[w]; c < 850 || c > 1150 {
+			t.Errorf("weight %d drawn %d of %d times: %v", w, c, n, counts[1:])
+			break
+		}
+	}
+}
+
+func TestWeightBounds(t *testing.T) {
+	if w, err := testUUID.Weight(1); err != nil || w != 1 {
+		t.Errorf("Weight(1) = %d, %v; want 1", w, err)
+	}
+	if w, err := testUUID.Weight(math.MaxUint32); err != nil || w < 1 {
+		t.Errorf("Weight(MaxUint32) = %d, %v", w, err)
+	}
+	if _, err := testUUID.Weight(0); err == nil {
+		t.Error("Weight(0): expected error")
+	}
+	if _, err := UUID("not-a-uuid").Weight(10); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+// rankCorrelation returns the Spearman rank correlation of xs and ys, which
+// must have the same length and no ties.
+func rankCorrelation(xs, ys []uint64) float64 {
+	ranks := func(v []uint64) []float64 {
+		idx := make([]int, len(v))
+		for i := range idx {
+			idx[i] = i
+		}
+		slices.SortFunc(idx, func(a, b int) int { return cmp.Compare(v[a], v[b]) })
+		r := make([]float64, len(v))
+		for rank, i := range idx {
+			r[i] = float64(rank)
+		}
+		return r
+	}
+	rx, ry := ranks(xs), ranks(ys)
+	n := float64(len(xs))
+	var d2 float64
+	for i := range rx {
+		d := rx[i] - ry[i]
+		d2 += d * d
+	}
+	return 1 - 6*d2/(n*(n*n-1))
+}
+
+func TestJitterIndependentOfRingPosition(t *testing.T) {
+	us := randomUUIDs(t, 2000)
+	jitters := make([]uint64, len(us))
+	positions := make([]uint64, len(us))
+	for i, u := range us {
+		j, err := u.Jitter(time.Duration(math.MaxInt64))
+		if err != nil {
+			t.Fatal(err)
+		}
+		jitters[i] = uint64(j)
+		if positions[i], err = u.RingPosition(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Independent values give a correlation of 0 with a standard deviation
+	// of about 0.022.
+	if rho := rankCorrelation(jitters, positions); math.Abs(rho) > 0.1 {
+		t.Errorf("Jitter and RingPosition rank correlation = %.3f, want about 0", rho)
+	}
+}
+
+func TestWeightIndependentOfJitter(t *testing.T) {
+	us := randomUUIDs(t, 2000)
+	weights := make([]uint64, len(us))
+	jitters := make([]uint64, len(us))
+	positions := make([]uint64, len(us))
+	for i, u := range us {
+		w, err := u.Weight(math.MaxUint32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		weights[i] = uint64(w)
+		j, err := u.Jitter(time.Duration(math.MaxInt64))
+		if err != nil {
+			t.Fatal(err)
+		}
+		jitters[i] = uint64(j)
+		if positions[i], err = u.RingPosition(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, other := range map[string][]uint64{"Jitter": jitters, "RingPosition": positions} {
+		if rho := rankCorrelation(weights, other); math.Abs(rho) > 0.1 {
+			t.Errorf("Weight and %s rank correlation = %.3f, want about 0", name, rho)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+)
+
+var (
+	hostTagOnce sync.Once
+	hostTagByte byte
+)
+
+// Ver4WithHostTag generates a random Version 4, Variant 1 UUID whose last
+// byte is a tag identifying the minting host. The tag is the first byte of
+// SHA-256 over the hostname and the hardware address of the first network
+// interface that has one; it is computed once per process and is stable
+// across calls. If neither is available, a random tag is chosen once per
+// process instead. The tag reduces the random bits from 122 to 114 and, at
+// 8 bits, only distinguishes hosts probabilistically.
+//
+// Returns:
+//   - UUID: A random UUID carrying the host tag.
+//   - error: An error if crypto/rand fails.
+func Ver4WithHostTag() (UUID, error) {
+	b, err := random16()
+	if err != nil {
+		return "", fmt.Errorf("Ver4WithHostTag: %w", err)
+	}
+	setVer4Var1(&b)
+	b[15] = hostTag()
+	return encode(b), nil
+}
+
+// HostTag returns the host tag of a UUID minted by Ver4WithHostTag, i.e. its
+// last byte.
+//
+// Returns:
+//   - byte: The host tag.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) HostTag() (byte, error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, fmt.Errorf("HostTag: %w", err)
+	}
+	return b[15], nil
+}
+
+// hostTag returns the process-wide host tag, computing it on first use.
+func hostTag() byte {
+	hostTagOnce.Do(func() {
+		h := sha256.New()
+		found := false
+		if name, err := os.Hostname(); err == nil && name != "" {
+			h.Write([]byte(name))
+			found = true
+		}
+		if ifaces, err := net.Interfaces(); err == nil {
+			for _, iface := range ifaces {
+				if len(iface.HardwareAddr) > 0 {
+					h.Write(iface.HardwareAddr)
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			if b, err := random16(); err == nil {
+				h.Write(b[:])
+			}
+		}
+		hostTagByte = h.Sum(nil)[0]
+	})
+	return hostTagByte
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestVer4WithHostTag(t *testing.T) {
+	var first byte
+	for i := range 20 {
+		u, err := Ver4WithHostTag()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) {
+			t.Fatalf("Ver4WithHostTag() = %s, want a valid v4 UUID", u)
+		}
+		tag, err := u.HostTag()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			first = tag
+		} else if tag != first {
+			t.Fatalf("host tag changed within the process: %#x != %#x", tag, first)
+		}
+	}
+	if first != hostTag() {
+		t.Errorf("HostTag() = %#x, want %#x", first, hostTag())
+	}
+	if _, err := UUID("not-a-uuid").HostTag(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
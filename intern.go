@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"fmt"
+	"sync"
+)
+
+// InterningParser parses UUIDs and interns the results, so that equal UUIDs
+// share one backing string. It suits documents with many repeated
+// references. The zero value is ready to use and a single parser may be
+// shared by multiple goroutines. Interned values are kept for the lifetime
+// of the parser.
+type InterningParser struct {
+	mu     sync.RWMutex
+	values map[[16]byte]UUID
+}
+
+// Parse validates s, which may be in canonical, braced, URN or compact form
+// in any case, and returns its lowercase canonical form. Every call that
+// yields the same UUID returns the same interned string; only the first
+// occurrence of a UUID allocates.
+//
+// Parameters:
+//   - s: The string to parse.
+//
+// Returns:
+//   - UUID: The interned canonical UUID.
+//   - error: An error if s is not a valid UUID.
+func (p *InterningParser) Parse(s string) (UUID, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return "", fmt.Errorf("InterningParser.Parse: %w", err)
+	}
+	p.mu.RLock()
+	u, ok := p.values[b]
+	p.mu.RUnlock()
+	if ok {
+		return u, nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if u, ok := p.values[b]; ok {
+		return u, nil
+	}
+	if p.values == nil {
+		p.values = make(map[[16]byte]UUID)
+	}
+	u = encode(b)
+	p.values[b] = u
+	return u, nil
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func TestInterningParser(t *testing.T) {
+	var p InterningParser
+	a, err := p.Parse(strings.ToUpper(string(testUUID)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := p.Parse("urn:uuid:" + string(testUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != testUUID || b != testUUID {
+		t.Fatalf("Parse() = %s, %s; want %s", a, b, testUUID)
+	}
+	if unsafe.StringData(string(a)) != unsafe.StringData(string(b)) {
+		t.Error("equal UUIDs do not share backing storage")
+	}
+	if _, err := p.Parse("not-a-uuid"); err == nil {
+		t.Error("invalid input: expected error")
+	}
+}
+
+func TestInterningParserConcurrent(t *testing.T) {
+	var p InterningParser
+	us := randomUUIDs(t, 16)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for _, u := range us {
+				if _, err := p.Parse(string(u)); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	for _, u := range us {
+		a, _ := p.Parse(string(u))
+		b, _ := p.Parse(strings.ToUpper(string(u)))
+		if unsafe.StringData(string(a)) != unsafe.StringData(string(b)) {
+			t.Errorf("%s was interned more than once", u)
+		}
+	}
+}
+
+func BenchmarkInterningParser(b *testing.B) {
+	var p InterningParser
+	s := strings.ToUpper(string(testUUID))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := p.Parse(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// ErrShardExhausted is returned by NextInShard when a shard has no
+// successor left within its prefix.
+var ErrShardExhausted = errors.New("shard exhausted")
+
+// ErrNoUpperBound is returned by PrefixUpperBound when a prefix has no
+// greater prefix of the same length.
+var ErrNoUpperBound = errors.New("prefix has no upper bound")
+
+// NextInShard returns the successor of last, treating UUIDs as 128-bit
+// big-endian integers, within the shard whose top prefixBits bits equal
+// those of prefix. The result is the raw increment: version and variant
+// bits are not maintained.
+//
+// Parameters:
+//   - prefix: A UUID whose top prefixBits bits identify the shard.
+//   - prefixBits: The number of leading bits owned by the shard, 0-128.
+//   - last: The most recently issued UUID in the shard.
+//
+// Returns:
+//   - UUID: The successor of last in lowercase canonical form.
+//   - error: An error wrapping ErrShardExhausted if last is the final UUID
+//     of the shard, or an error if the inputs are invalid or last lies
+//     outside the shard.
+func NextInShard(prefix UUID, prefixBits int, last UUID) (UUID, error) {
+	if prefixBits < 0 || prefixBits > 128 {
+		return "", fmt.Errorf(
+			"NextInShard: prefix bits must be in [0, 128]: %d", prefixBits,
+		)
+	}
+	p, err := decode(prefix)
+	if err != nil {
+		return "", fmt.Errorf("NextInShard: prefix: %w", err)
+	}
+	l, err := decode(last)
+	if err != nil {
+		return "", fmt.Errorf("NextInShard: last: %w", err)
+	}
+	if !samePrefix(p, l, prefixBits) {
+		return "", fmt.Errorf(
+			"NextInShard: %s is outside the shard of %s/%d",
+			last, prefix, prefixBits,
+		)
+	}
+	hi, lo := toUint128(l)
+	lo, carry := bits.Add64(lo, 1, 0)
+	hi, overflow := bits.Add64(hi, 0, carry)
+	next := fromUint128(hi, lo)
+	if overflow != 0 || !samePrefix(p, next, prefixBits) {
+		return "", fmt.Errorf(
+			"NextInShard: %w: %s/%d", ErrShardExhausted, prefix, prefixBits,
+		)
+	}
+	return encode(next), nil
+}
+
+// PrefixUpperBound returns the exclusive upper bound of all UUIDs sharing
+// the top prefixBits bits of prefix: the smallest UUID whose top prefixBits
+// bits are greater than prefix's, with all remaining bits zero. A prefix
+// scan is then the range [prefix with the remaining bits cleared,
+// PrefixUpperBound). The result is a raw 128-bit value: version and variant
+// bits are not maintained.
+//
+// When the top prefixBits bits of prefix are all ones, including the case
+// prefixBits == 0, no such UUID exists; the scan extends to the Max UUID
+// (all bits set) inclusive and ErrNoUpperBound is returned.
+//
+// Parameters:
+//   - prefix: A UUID whose top prefixBits bits form the prefix.
+//   - prefixBits: The prefix length in bits, 0-128.
+//
+// Returns:
+//   - UUID: The exclusive upper bound in lowercase canonical form.
+//   - error: An error wrapping ErrNoUpperBound if the prefix is all ones,
+//     or an error if the inputs are invalid.
+func PrefixUpperBound(prefix UUID, prefixBits int) (UUID, error) {
+	if prefixBits < 0 || prefixBits > 128 {
+		return "", fmt.Errorf(
+			"PrefixUpperBound: prefix bits must be in [0, 128]: %d", prefixBits,
+		)
+	}
+	p, err := decode(prefix)
+	if err != nil {
+		return "", fmt.Errorf("PrefixUpperBound: %w", err)
+	}
+	mh, ml := prefixMask(prefixBits)
+	hi, lo := toUint128(p)
+	hi, lo = hi|^mh, lo|^ml
+	lo, carry := bits.Add64(lo, 1, 0)
+	hi, overflow := bits.Add64(hi, 0, carry)
+	if overflow != 0 {
+		return "", fmt.Errorf(
+			"PrefixUpperBound: %w: %s/%d", ErrNoUpperBound, prefix, prefixBits,
+		)
+	}
+	return encode(fromUint128(hi, lo)), nil
+}
+
+// Palette returns n UUIDs evenly spaced across the keyspace: the i-th value
+// is i × floor(2^128 / n) as a 128-bit big-endian integer, starting at the
+// Nil UUID. The same n always yields the same strictly increasing set. The
+// values are raw 128-bit integers without version or variant bits.
+//
+// Parameters:
+//   - n: The number of UUIDs. A non-positive n yields an empty slice.
+//
+// Returns:
+//   - []UUID: The UUIDs in lowercase canonical form.
+func Palette(n int) []UUID {
+	if n <= 0 {
+		return []UUID{}
+	}
+	step := new(big.Int).Lsh(big.NewInt(1), 128)
+	step.Div(step, big.NewInt(int64(n)))
+	out := make([]UUID, n)
+	v := new(big.Int)
+	for i := range out {
+		var b [16]byte
+		v.FillBytes(b[:])
+		out[i] = encode(b)
+		v.Add(v, step)
+	}
+	return out
+}
+
+// HistogramBucket maps the UUID to one of buckets equal-width ranges of the
+// keyspace by its value as a 128-bit big-endian integer v, returning
+// floor(v × buckets / 2^128). Unlike HashBucket, this preserves order: if
+// a ≤ b in raw byte order, a's bucket is at most b's, so a histogram of
+// bucket counts shows where keys concentrate.
+//
+// Parameters:
+//   - buckets: The number of buckets. Must be positive.
+//
+// Returns:
+//   - int: The bucket index in [0, buckets).
+//   - error: An error if buckets is not positive or the receiver is not a
+//     valid UUID.
+func (u UUID) HistogramBucket(buckets int) (int, error) {
+	if buckets <= 0 {
+		return 0, fmt.Errorf(
+			"HistogramBucket: bucket count must be positive: %d", buckets,
+		)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return 0, fmt.Errorf("HistogramBucket: %w", err)
+	}
+	hi, lo := toUint128(b)
+	n := uint64(buckets)
+	top, mid := bits.Mul64(hi, n)
+	frac, _ := bits.Mul64(lo, n)
+	_, c := bits.Add64(mid, frac, 0)
+	return int(top + c), nil
+}
+
+// toUint128 splits b into its big-endian high and low 64-bit words.
+func toUint128(b [16]byte) (hi, lo uint64) {
+	return binary.BigEndian.Uint64(b[0:8]), binary.BigEndian.Uint64(b[8:16])
+}
+
+// fromUint128 joins big-endian high and low 64-bit words into 16 bytes.
+func fromUint128(hi, lo uint64) [16]byte {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[0:8], hi)
+	binary.BigEndian.PutUint64(b[8:16], lo)
+	return b
+}
+
+// prefixMask returns the high and low words of a mask covering the top n
+// bits of a 128-bit value.
+func prefixMask(n int) (hi, lo uint64) {
+	switch {
+	case n <= 0:
+		return 0, 0
+	case n < 64:
+		return ^uint64(0) << (64 - n), 0
+	case n < 128:
+		return ^uint64(0), ^uint64(0) << (128 - n)
+	}
+	return ^uint64(0), ^uint64(0)
+}
+
+// samePrefix reports whether a and b agree in their top n bits.
+func samePrefix(a, b [16]byte, n int) bool {
+	mh, ml := prefixMask(n)
+	ah, al := toUint128(a)
+	bh, bl := toUint128(b)
+	return ah&mh == bh&mh && al&ml == bl&ml
+}
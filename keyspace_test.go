@@ -0,0 +1,187 @@
+package uuid
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func TestNextInShard(t *testing.T) {
+	prefix := UUID("ab000000-0000-0000-0000-000000000000")
+	got, err := NextInShard(prefix, 8, "ab000000-0000-0000-0000-0000000000ff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("ab000000-0000-0000-0000-000000000100"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	got, err = NextInShard(prefix, 8, "abffffff-ffff-ffff-ffff-fffffffffffe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("abffffff-ffff-ffff-ffff-ffffffffffff"); got != want {
+		t.Errorf("last slot: got %s, want %s", got, want)
+	}
+}
+
+func TestNextInShardOverflow(t *testing.T) {
+	prefix := UUID("ab000000-0000-0000-0000-000000000000")
+	_, err := NextInShard(prefix, 8, "abffffff-ffff-ffff-ffff-ffffffffffff")
+	if !errors.Is(err, ErrShardExhausted) {
+		t.Errorf("shard end: got %v, want ErrShardExhausted", err)
+	}
+	_, err = NextInShard(prefix, 0, "ffffffff-ffff-ffff-ffff-ffffffffffff")
+	if !errors.Is(err, ErrShardExhausted) {
+		t.Errorf("keyspace end: got %v, want ErrShardExhausted", err)
+	}
+}
+
+func TestNextInShardErrors(t *testing.T) {
+	prefix := UUID("ab000000-0000-0000-0000-000000000000")
+	if _, err := NextInShard(prefix, 8, "ac000000-0000-0000-0000-000000000000"); err == nil {
+		t.Error("last outside shard: expected error")
+	}
+	if _, err := NextInShard(prefix, 129, prefix); err == nil {
+		t.Error("prefix bits out of range: expected error")
+	}
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	tests := []struct {
+		prefix UUID
+		bits   int
+		want   UUID
+	}{
+		{"ab000000-0000-0000-0000-000000000000", 8, "ac000000-0000-0000-0000-000000000000"},
+		{"abcdef12-0000-0000-0000-000000000000", 8, "ac000000-0000-0000-0000-000000000000"},
+		{"a0000000-0000-0000-0000-000000000000", 3, "c0000000-0000-0000-0000-000000000000"},
+		{"00ffffff-ffff-ffff-ffff-ffffffffffff", 8, "01000000-0000-0000-0000-000000000000"},
+		{"12345678-9abc-def0-ffff-ffffffffffff", 64, "12345678-9abc-def1-0000-000000000000"},
+		{"00000000-0000-0000-0000-000000000000", 128, "00000000-0000-0000-0000-000000000001"},
+		{"00000000-0000-0000-ffff-ffffffffffff", 128, "00000000-0000-0001-0000-000000000000"},
+	}
+	for _, tt := range tests {
+		got, err := PrefixUpperBound(tt.prefix, tt.bits)
+		if err != nil {
+			t.Fatalf("PrefixUpperBound(%s, %d): %v", tt.prefix, tt.bits, err)
+		}
+		if got != tt.want {
+			t.Errorf("PrefixUpperBound(%s, %d) = %s, want %s", tt.prefix, tt.bits, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixUpperBoundAllOnes(t *testing.T) {
+	tests := []struct {
+		prefix UUID
+		bits   int
+	}{
+		{testUUID, 0},
+		{"ff000000-0000-0000-0000-000000000000", 8},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 128},
+	}
+	for _, tt := range tests {
+		_, err := PrefixUpperBound(tt.prefix, tt.bits)
+		if !errors.Is(err, ErrNoUpperBound) {
+			t.Errorf("PrefixUpperBound(%s, %d) error = %v, want ErrNoUpperBound", tt.prefix, tt.bits, err)
+		}
+	}
+}
+
+func TestPrefixUpperBoundErrors(t *testing.T) {
+	for _, bits := range []int{-1, 129} {
+		if _, err := PrefixUpperBound(testUUID, bits); err == nil {
+			t.Errorf("PrefixUpperBound(%d bits): expected error", bits)
+		}
+	}
+	if _, err := PrefixUpperBound("not-a-uuid", 8); err == nil {
+		t.Error("invalid prefix: expected error")
+	}
+}
+
+func TestPaletteEvenlySpaced(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 16} {
+		us := Palette(n)
+		if len(us) != n {
+			t.Fatalf("Palette(%d) has %d elements", n, len(us))
+		}
+		if us[0] != "00000000-0000-0000-0000-000000000000" {
+			t.Errorf("Palette(%d)[0] = %s, want the Nil UUID", n, us[0])
+		}
+		step := new(big.Int).Lsh(big.NewInt(1), 128)
+		step.Div(step, big.NewInt(int64(n)))
+		for i := 1; i < n; i++ {
+			prev, _ := decode(us[i-1])
+			cur, _ := decode(us[i])
+			if compare(prev, cur) >= 0 {
+				t.Fatalf("Palette(%d) not strictly increasing at %d", n, i)
+			}
+			d := new(big.Int).Sub(
+				new(big.Int).SetBytes(cur[:]), new(big.Int).SetBytes(prev[:]),
+			)
+			if d.Cmp(step) != 0 {
+				t.Errorf("Palette(%d) gap %d = %v, want %v", n, i, d, step)
+			}
+		}
+		again := Palette(n)
+		for i := range us {
+			if again[i] != us[i] {
+				t.Fatalf("Palette(%d) not deterministic", n)
+			}
+		}
+	}
+	if got := Palette(4)[1]; got != "40000000-0000-0000-0000-000000000000" {
+		t.Errorf("Palette(4)[1] = %s, want 40000000-0000-0000-0000-000000000000", got)
+	}
+	if got := Palette(0); len(got) != 0 {
+		t.Errorf("Palette(0) = %v, want empty", got)
+	}
+}
+
+func TestHistogramBucketPreservesOrder(t *testing.T) {
+	for _, buckets := range []int{1, 3, 10, 256} {
+		us := randomUUIDs(t, 200)
+		us = append(us,
+			"00000000-0000-0000-0000-000000000000",
+			"ffffffff-ffff-ffff-ffff-ffffffffffff",
+		)
+		slices.Sort(us)
+		prev := 0
+		for i, u := range us {
+			got, err := u.HistogramBucket(buckets)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got < 0 || got >= buckets {
+				t.Fatalf("HistogramBucket(%d) = %d for %s, out of range", buckets, got, u)
+			}
+			if i > 0 && got < prev {
+				t.Errorf("HistogramBucket(%d): %s in bucket %d after bucket %d", buckets, u, got, prev)
+			}
+			prev = got
+		}
+		if first, _ := us[0].HistogramBucket(buckets); first != 0 {
+			t.Errorf("HistogramBucket(%d) of the Nil UUID = %d, want 0", buckets, first)
+		}
+		if prev != buckets-1 {
+			t.Errorf("HistogramBucket(%d) of the Max UUID = %d, want %d", buckets, prev, buckets-1)
+		}
+	}
+	// With a power of two, Palette(n) places the i-th UUID exactly at the
+	// start of bucket i of n.
+	for i, u := range Palette(16) {
+		if got, _ := u.HistogramBucket(16); got != i {
+			t.Errorf("Palette(16)[%d] in bucket %d, want %d", i, got, i)
+		}
+	}
+}
+
+func TestHistogramBucketErrors(t *testing.T) {
+	if _, err := testUUID.HistogramBucket(0); err == nil {
+		t.Error("zero buckets: expected error")
+	}
+	if _, err := UUID("not-a-uuid").HistogramBucket(4); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
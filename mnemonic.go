@@ -0,0 +1,121 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// Letters of the generated mnemonic word list. Word i, for i in [0, 2048),
+// is mnemonicOnsets[i>>7] + mnemonicVowels[i>>5&3] +
+// mnemonicMiddles[i>>2&7] + mnemonicVowels[i&3].
+const (
+	mnemonicOnsets  = "bdfghjklmnprstvz"
+	mnemonicVowels  = "aeio"
+	mnemonicMiddles = "bdklmnrs"
+)
+
+// mnemonicWords is the number of words in a mnemonic phrase.
+const mnemonicWords = 12
+
+// Mnemonic encodes the UUID as a 12-word phrase with a built-in checksum,
+// for error-detecting spoken or written backups. The layout follows BIP39
+// for 128 bits of entropy: the 16 bytes are followed by the first 4 bits of
+// their SHA-256 digest, and the resulting 132 bits are split into twelve
+// 11-bit indexes, most significant bit first.
+//
+// The word list is not the BIP39 list, so phrases are not interchangeable
+// with wallets. It is generated: each index selects a pronounceable
+// four-letter consonant-vowel-consonant-vowel word such as "bako": the
+// top 4 bits pick the first letter from "bdfghjklmnprstvz", the next 2 a
+// vowel from "aeio", the next 3 a consonant from "bdklmnrs" and the last 2
+// another vowel. All 2048 words are distinct.
+//
+// Returns:
+//   - string: The twelve lowercase words separated by single spaces.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Mnemonic() (string, error) {
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("Mnemonic: %w", err)
+	}
+	var buf [17]byte
+	copy(buf[:16], b[:])
+	buf[16] = mnemonicChecksum(b) << 4
+	words := make([]string, mnemonicWords)
+	for i := range words {
+		idx := 0
+		for bit := i * 11; bit < (i+1)*11; bit++ {
+			idx = idx<<1 | int(buf[bit/8]>>(7-bit%8)&1)
+		}
+		words[i] = mnemonicWord(idx)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// FromMnemonic decodes a phrase produced by Mnemonic and verifies its
+// checksum. Words may be in any case and separated by any whitespace. The
+// 4-bit checksum rejects a phrase with one wrong word with probability
+// 15/16; no 4-bit checksum can catch every substitution of an 11-bit word.
+//
+// Parameters:
+//   - phrase: The mnemonic phrase.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if the phrase does not have 12 words, contains an
+//     unknown word, or fails the checksum.
+func FromMnemonic(phrase string) (UUID, error) {
+	words := strings.Fields(strings.ToLower(phrase))
+	if len(words) != mnemonicWords {
+		return "", fmt.Errorf(
+			"FromMnemonic: expected %d words, got %d", mnemonicWords, len(words),
+		)
+	}
+	var buf [17]byte
+	for i, w := range words {
+		idx, ok := mnemonicIndex(w)
+		if !ok {
+			return "", fmt.Errorf("FromMnemonic: unknown word %d: %q", i, w)
+		}
+		for j := range 11 {
+			bit := i*11 + j
+			buf[bit/8] |= byte(idx>>(10-j)&1) << (7 - bit%8)
+		}
+	}
+	var b [16]byte
+	copy(b[:], buf[:16])
+	if buf[16]>>4 != mnemonicChecksum(b) {
+		return "", fmt.Errorf("FromMnemonic: checksum mismatch: %s", phrase)
+	}
+	return encode(b), nil
+}
+
+// mnemonicChecksum returns the first 4 bits of the SHA-256 digest of b.
+func mnemonicChecksum(b [16]byte) byte {
+	sum := sha256.Sum256(b[:])
+	return sum[0] >> 4
+}
+
+// mnemonicWord returns the word for an 11-bit index.
+func mnemonicWord(i int) string {
+	return string([]byte{
+		mnemonicOnsets[i>>7], mnemonicVowels[i>>5&3],
+		mnemonicMiddles[i>>2&7], mnemonicVowels[i&3],
+	})
+}
+
+// mnemonicIndex returns the 11-bit index of a word.
+func mnemonicIndex(w string) (int, bool) {
+	if len(w) != 4 {
+		return 0, false
+	}
+	onset := strings.IndexByte(mnemonicOnsets, w[0])
+	v1 := strings.IndexByte(mnemonicVowels, w[1])
+	middle := strings.IndexByte(mnemonicMiddles, w[2])
+	v2 := strings.IndexByte(mnemonicVowels, w[3])
+	if onset < 0 || v1 < 0 || middle < 0 || v2 < 0 {
+		return 0, false
+	}
+	return onset<<7 | v1<<5 | middle<<2 | v2, true
+}
@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMnemonicRoundTrip(t *testing.T) {
+	for _, u := range append(randomUUIDs(t, 100), testUUID) {
+		phrase, err := u.Mnemonic()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(strings.Fields(phrase)); n != mnemonicWords {
+			t.Fatalf("Mnemonic() = %q has %d words", phrase, n)
+		}
+		got, err := FromMnemonic(phrase)
+		if err != nil || got != u {
+			t.Fatalf("FromMnemonic(%q) = %s, %v; want %s", phrase, got, err, u)
+		}
+	}
+	phrase, _ := testUUID.Mnemonic()
+	messy := " " + strings.ReplaceAll(strings.ToUpper(phrase), " ", "\n\t") + " "
+	if got, err := FromMnemonic(messy); err != nil || got != testUUID {
+		t.Errorf("FromMnemonic(%q) = %s, %v; want %s", messy, got, err, testUUID)
+	}
+}
+
+func TestMnemonicWrongWord(t *testing.T) {
+	phrase, err := testUUID.Mnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := strings.Fields(phrase)
+	last, _ := mnemonicIndex(words[len(words)-1])
+	// The low 4 bits of the last word are the checksum itself, so any
+	// change to them alone must be rejected.
+	for x := 1; x < 16; x++ {
+		swapped := append([]string(nil), words...)
+		swapped[len(swapped)-1] = mnemonicWord(last ^ x)
+		bad := strings.Join(swapped, " ")
+		if _, err := FromMnemonic(bad); err == nil {
+			t.Errorf("FromMnemonic(%q): expected checksum error", bad)
+		}
+	}
+	for _, bad := range []string{
+		"",
+		strings.Join(words[:11], " "),
+		phrase + " bako",
+		strings.Replace(phrase, words[0], "xxxx", 1),
+	} {
+		if _, err := FromMnemonic(bad); err == nil {
+			t.Errorf("FromMnemonic(%q): expected error", bad)
+		}
+	}
+	if _, err := UUID("not-a-uuid").Mnemonic(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTenantObfuscate(t *testing.T) {
+	keyA, keyB := []byte("tenant-a"), []byte("tenant-b")
+	for _, u := range randomUUIDs(t, 20) {
+		a, err := u.TenantObfuscate(keyA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := u.TenantObfuscate(keyB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a == b || a == u || b == u {
+			t.Errorf("obfuscations of %s are not distinct: %s, %s", u, a, b)
+		}
+		again, _ := UUID(strings.ToUpper(string(u))).TenantObfuscate(keyA)
+		if again != a {
+			t.Errorf("TenantObfuscate(%s) not deterministic: %s != %s", u, again, a)
+		}
+		back, err := a.TenantDeobfuscate(keyA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if back != u {
+			t.Errorf("TenantDeobfuscate(%s) = %s, want %s", a, back, u)
+		}
+		if wrong, _ := a.TenantDeobfuscate(keyB); wrong == u {
+			t.Errorf("%s deobfuscated under the wrong key", a)
+		}
+	}
+}
+
+func TestTenantObfuscateErrors(t *testing.T) {
+	if _, err := testUUID.TenantObfuscate(nil); err == nil {
+		t.Error("empty key: expected error")
+	}
+	if _, err := UUID("not-a-uuid").TenantDeobfuscate([]byte("k")); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
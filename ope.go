@@ -0,0 +1,180 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// opeDomainBits is the number of random bits in a Version 4, Variant 1
+// UUID, which form the OPE plaintext.
+const opeDomainBits = 122
+
+// OPEEncrypt encrypts a Version 4, Variant 1 UUID with a simple
+// order-preserving encryption keyed by key: for v4 UUIDs a and b, a < b in
+// raw byte order implies OPEEncrypt(a) < OPEEncrypt(b). The 122 random
+// bits are mapped into the full 128-bit space by recursively splitting the
+// plaintext and ciphertext intervals at points chosen with HMAC-SHA256,
+// so the result is a raw 128-bit value without version or variant bits.
+// Decrypt it with OPEDecrypt and the same key.
+//
+// Security limitations: OPE is deterministic and, by design, reveals the
+// order of all ciphertexts, which is enough to reveal equality, rough
+// magnitude and, for constructions of this kind, approximately the upper
+// half of the plaintext bits. It offers no semantic security and must not
+// be used where the ordering or distribution of the keys is sensitive.
+//
+// Parameters:
+//   - key: The secret key. Must not be empty.
+//
+// Returns:
+//   - UUID: The ciphertext as a UUID-formatted 128-bit value.
+//   - error: An error if key is empty or the receiver is not a valid
+//     Version 4, Variant 1 UUID.
+func (u UUID) OPEEncrypt(key []byte) (UUID, error) {
+	if len(key) == 0 {
+		return "", errors.New("OPEEncrypt: empty key")
+	}
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("OPEEncrypt: %w", err)
+	}
+	if b[6]>>4 != 4 || b[8]&0xc0 != 0x80 {
+		return "", fmt.Errorf("OPEEncrypt: not a Version 4, Variant 1 UUID: %s", u)
+	}
+	hi, lo := toUint128(b)
+	x := new(big.Int).SetUint64(hi>>16<<12 | hi&0x0fff)
+	x.Lsh(x, 62).Or(x, new(big.Int).SetUint64(lo&(1<<62-1)))
+
+	o := newOPE(key)
+	for o.dn.Cmp(one) > 0 {
+		dmid, rmid := o.split()
+		if x.Cmp(dmid) < 0 {
+			o.left(dmid, rmid)
+		} else {
+			o.right(dmid, rmid)
+		}
+	}
+	return encode(bigToBytes(o.leaf())), nil
+}
+
+// OPEDecrypt decrypts a ciphertext produced by OPEEncrypt with the same key.
+//
+// Parameters:
+//   - key: The secret key. Must not be empty.
+//
+// Returns:
+//   - UUID: The Version 4, Variant 1 plaintext in lowercase canonical form.
+//   - error: An error if key is empty, the receiver is not a valid UUID, or
+//     it is not a ciphertext under key.
+func (u UUID) OPEDecrypt(key []byte) (UUID, error) {
+	if len(key) == 0 {
+		return "", errors.New("OPEDecrypt: empty key")
+	}
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("OPEDecrypt: %w", err)
+	}
+	y := new(big.Int).SetBytes(b[:])
+
+	o := newOPE(key)
+	for o.dn.Cmp(one) > 0 {
+		dmid, rmid := o.split()
+		if y.Cmp(rmid) < 0 {
+			o.left(dmid, rmid)
+		} else {
+			o.right(dmid, rmid)
+		}
+	}
+	if y.Cmp(o.leaf()) != 0 {
+		return "", fmt.Errorf("OPEDecrypt: not a ciphertext under this key: %s", u)
+	}
+
+	var x [16]byte
+	o.dlo.FillBytes(x[:])
+	hi, lo := toUint128(x)
+	hi60 := hi<<2 | lo>>62
+	out := fromUint128(hi60>>12<<16|hi60&0x0fff, lo&(1<<62-1))
+	setVer4Var1(&out)
+	return encode(out), nil
+}
+
+// one is the big.Int constant 1.
+var one = big.NewInt(1)
+
+// ope tracks the current plaintext interval [dlo, dlo+dn) and ciphertext
+// interval [rlo, rlo+rn) while walking the split tree.
+type ope struct {
+	key     []byte
+	depth   byte
+	dlo, dn *big.Int
+	rlo, rn *big.Int
+}
+
+// newOPE starts a walk at the root, mapping 2^122 plaintexts into 2^128
+// ciphertexts.
+func newOPE(key []byte) *ope {
+	return &ope{
+		key: key,
+		dlo: new(big.Int),
+		dn:  new(big.Int).Lsh(one, opeDomainBits),
+		rlo: new(big.Int),
+		rn:  new(big.Int).Lsh(one, 128),
+	}
+}
+
+// split returns the plaintext midpoint of the current node and the keyed
+// ciphertext split point, chosen so that both halves of the ciphertext
+// interval are at least as large as the matching plaintext halves.
+func (o *ope) split() (dmid, rmid *big.Int) {
+	ld := new(big.Int).Rsh(o.dn, 1)
+	dmid = new(big.Int).Add(o.dlo, ld)
+	slack := new(big.Int).Sub(o.rn, o.dn)
+	slack.Add(slack, one)
+	rmid = o.prf(slack)
+	rmid.Add(rmid, o.rlo).Add(rmid, ld)
+	return dmid, rmid
+}
+
+// left descends into the lower half of the current node.
+func (o *ope) left(dmid, rmid *big.Int) {
+	o.dn = new(big.Int).Sub(dmid, o.dlo)
+	o.rn = new(big.Int).Sub(rmid, o.rlo)
+	o.depth++
+}
+
+// right descends into the upper half of the current node.
+func (o *ope) right(dmid, rmid *big.Int) {
+	o.dn.Sub(o.dn, new(big.Int).Sub(dmid, o.dlo))
+	o.rn.Sub(o.rn, new(big.Int).Sub(rmid, o.rlo))
+	o.dlo, o.rlo = dmid, rmid
+	o.depth++
+}
+
+// leaf returns the ciphertext of the single plaintext left in the current
+// node.
+func (o *ope) leaf() *big.Int {
+	y := o.prf(o.rn)
+	return y.Add(y, o.rlo)
+}
+
+// prf returns a keyed pseudo-random value in [0, n) bound to the current
+// node.
+func (o *ope) prf(n *big.Int) *big.Int {
+	var node [17]byte
+	o.dlo.FillBytes(node[:16])
+	node[16] = o.depth
+	mac := hmac.New(sha256.New, o.key)
+	mac.Write(node[:])
+	v := new(big.Int).SetBytes(mac.Sum(nil))
+	return v.Mod(v, n)
+}
+
+// bigToBytes returns the low 128 bits of v as 16 big-endian bytes.
+func bigToBytes(v *big.Int) [16]byte {
+	var b [16]byte
+	v.FillBytes(b[:])
+	return b
+}
@@ -0,0 +1,47 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOPEPreservesOrder(t *testing.T) {
+	key := []byte("ope test key")
+	us := randomUUIDs(t, 64)
+	slices.Sort(us)
+	us = slices.Compact(us)
+	var prev UUID
+	for i, u := range us {
+		c, err := u.OPEEncrypt(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 && c <= prev {
+			t.Fatalf("OPEEncrypt(%s) = %s, not above the previous ciphertext %s", u, c, prev)
+		}
+		prev = c
+		p, err := c.OPEDecrypt(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p != u {
+			t.Errorf("OPEDecrypt(OPEEncrypt(%s)) = %s", u, p)
+		}
+		again, _ := u.OPEEncrypt(key)
+		if again != c {
+			t.Errorf("OPEEncrypt(%s) not deterministic", u)
+		}
+	}
+}
+
+func TestOPEErrors(t *testing.T) {
+	if _, err := testUUID.OPEEncrypt(nil); err == nil {
+		t.Error("empty key: expected error")
+	}
+	if _, err := UUID("6f1a0b1c-8d7e-7a2b-8c9d-1e2f3a4b5c6d").OPEEncrypt([]byte("k")); err == nil {
+		t.Error("non-v4 plaintext: expected error")
+	}
+	if _, err := UUID("not-a-uuid").OPEDecrypt([]byte("k")); err == nil {
+		t.Error("invalid ciphertext: expected error")
+	}
+}
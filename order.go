@@ -0,0 +1,236 @@
+package uuid
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// BoundingRange returns the minimum and maximum UUIDs of us, ordered by
+// their 16 raw bytes compared big-endian. This is the order of lowercase
+// canonical strings, so it is independent of input casing. The returned
+// values are elements of us as given.
+//
+// Parameters:
+//   - us: The UUIDs to scan.
+//
+// Returns:
+//   - UUID: The smallest UUID.
+//   - UUID: The largest UUID.
+//   - error: An error if us is empty or contains an invalid UUID.
+func BoundingRange(us []UUID) (lo UUID, hi UUID, err error) {
+	if len(us) == 0 {
+		return "", "", errors.New("BoundingRange: empty input")
+	}
+	var loB, hiB [16]byte
+	for i, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return "", "", fmt.Errorf("BoundingRange: element %d: %w", i, err)
+		}
+		if i == 0 || compare(b, loB) < 0 {
+			lo, loB = u, b
+		}
+		if i == 0 || compare(b, hiB) > 0 {
+			hi, hiB = u, b
+		}
+	}
+	return lo, hi, nil
+}
+
+// MergeSorted performs a k-way merge of streams into a single slice sorted
+// by raw byte order, as used by BoundingRange. Each stream must already be
+// sorted in that order; the result is unspecified otherwise. Elements that
+// compare equal, within or across streams, appear once, as the first one
+// merged. Invalid UUIDs are skipped.
+//
+// Parameters:
+//   - streams: The individually sorted slices to merge.
+//
+// Returns:
+//   - []UUID: The merged, deduplicated UUIDs.
+func MergeSorted(streams ...[]UUID) []UUID {
+	h := mergeHeap{}
+	total := 0
+	for i, s := range streams {
+		total += len(s)
+		if item, ok := mergeHead(streams, i, 0); ok {
+			h = append(h, item)
+		}
+	}
+	heap.Init(&h)
+	out := make([]UUID, 0, total)
+	var last [16]byte
+	for len(h) > 0 {
+		top := h[0]
+		if len(out) == 0 || compare(top.key, last) != 0 {
+			out = append(out, streams[top.stream][top.index])
+			last = top.key
+		}
+		if next, ok := mergeHead(streams, top.stream, top.index+1); ok {
+			h[0] = next
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return out
+}
+
+// mergeItem is the head of one stream during MergeSorted.
+type mergeItem struct {
+	key    [16]byte
+	stream int
+	index  int
+}
+
+// mergeHead returns the first valid element of streams[stream] at or after
+// index.
+func mergeHead(streams [][]UUID, stream, index int) (mergeItem, bool) {
+	for ; index < len(streams[stream]); index++ {
+		if b, err := decode(streams[stream][index]); err == nil {
+			return mergeItem{key: b, stream: stream, index: index}, true
+		}
+	}
+	return mergeItem{}, false
+}
+
+// mergeHeap is a min-heap of stream heads ordered by key.
+type mergeHeap []mergeItem
+
+func (h mergeHeap) Len() int           { return len(h) }
+func (h mergeHeap) Less(i, j int) bool { return compare(h[i].key, h[j].key) < 0 }
+func (h mergeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x any)        { *h = append(*h, x.(mergeItem)) }
+
+func (h *mergeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Percentile returns the element of us at the p-th percentile in raw byte
+// order, as used by BoundingRange, using the nearest-rank method: the
+// element at rank ceil(p/100 × len(us)), where the 0th percentile is the
+// minimum. The input is not modified.
+//
+// Parameters:
+//   - us: The UUIDs to rank.
+//   - p: The percentile, in [0, 100].
+//
+// Returns:
+//   - UUID: The element of us at the percentile.
+//   - error: An error if us is empty, p is out of range, or us contains an
+//     invalid UUID.
+func Percentile(us []UUID, p float64) (UUID, error) {
+	if len(us) == 0 {
+		return "", errors.New("Percentile: empty input")
+	}
+	if !(p >= 0 && p <= 100) {
+		return "", fmt.Errorf("Percentile: percentile must be in [0, 100]: %v", p)
+	}
+	idx := make([]int, len(us))
+	keys := make([][16]byte, len(us))
+	for i, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return "", fmt.Errorf("Percentile: element %d: %w", i, err)
+		}
+		idx[i], keys[i] = i, b
+	}
+	slices.SortStableFunc(idx, func(a, b int) int {
+		return compare(keys[a], keys[b])
+	})
+	rank := int(math.Ceil(p / 100 * float64(len(us))))
+	return us[idx[max(rank-1, 0)]], nil
+}
+
+// IsSorted reports whether us is non-decreasing in raw byte order, as used
+// by BoundingRange and required by MergeSorted. Equal neighbours are
+// allowed. A slice containing an invalid UUID is reported as unsorted.
+//
+// Parameters:
+//   - us: The UUIDs to check.
+//
+// Returns:
+//   - bool: True if us is valid and sorted.
+func IsSorted(us []UUID) bool {
+	var prev [16]byte
+	for i, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return false
+		}
+		if i > 0 && compare(prev, b) > 0 {
+			return false
+		}
+		prev = b
+	}
+	return true
+}
+
+// CompareMixed compares two UUIDs of any version by their 16 raw bytes,
+// big-endian. This is a total order across versions and variants, and the
+// order used by BoundingRange, MergeSorted and IsSorted, so it can serve as
+// the comparator of a heterogeneous sorted index.
+//
+// Parameters:
+//   - a: The first UUID.
+//   - b: The second UUID.
+//
+// Returns:
+//   - int: -1 if a < b, 0 if a == b and +1 if a > b.
+//   - error: An error if either UUID is invalid.
+func CompareMixed(a, b UUID) (int, error) {
+	x, y, err := decodePair(a, b)
+	if err != nil {
+		return 0, fmt.Errorf("CompareMixed: %w", err)
+	}
+	return compare(x, y), nil
+}
+
+// compare orders two UUIDs by their raw bytes, big-endian.
+func compare(a, b [16]byte) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// Gaps measures the spacing of a sorted slice: for each adjacent pair it
+// returns the difference between the high 64 bits (the first 8 bytes,
+// big-endian) of the later and the earlier UUID. Unusually large gaps mark
+// underpopulated regions of the keyspace. Differences in the low 64 bits
+// are ignored, so UUIDs sharing their first 8 bytes have a gap of 0.
+//
+// The input must be non-decreasing in raw byte order, as checked by
+// IsSorted; an out-of-order pair is reported as an error.
+//
+// Parameters:
+//   - sorted: The UUIDs, in raw byte order.
+//
+// Returns:
+//   - []uint64: The len(sorted)-1 gaps, empty if sorted has fewer than two
+//     elements.
+//   - error: An error if sorted contains an invalid UUID or is not sorted.
+func Gaps(sorted []UUID) ([]uint64, error) {
+	gaps := make([]uint64, 0, max(len(sorted)-1, 0))
+	var prev [16]byte
+	for i, u := range sorted {
+		b, err := decode(u)
+		if err != nil {
+			return nil, fmt.Errorf("Gaps: element %d: %w", i, err)
+		}
+		if i > 0 {
+			if compare(prev, b) > 0 {
+				return nil, fmt.Errorf("Gaps: element %d: input not sorted", i)
+			}
+			hi, _ := toUint128(b)
+			prevHi, _ := toUint128(prev)
+			gaps = append(gaps, hi-prevHi)
+		}
+		prev = b
+	}
+	return gaps, nil
+}
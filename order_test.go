@@ -0,0 +1,201 @@
+package uuid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBoundingRange(t *testing.T) {
+	lo, hi, err := BoundingRange([]UUID{testUUID})
+	if err != nil || lo != testUUID || hi != testUUID {
+		t.Errorf("single element: got %s, %s, %v", lo, hi, err)
+	}
+
+	us := []UUID{
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+		"00000000-0000-4000-8000-000000000001",
+		"FFFFFFFF-0000-4000-8000-000000000000",
+		"0a1b2c3d-4e5f-4a6b-9c7d-8e9f0a1b2c3d",
+	}
+	lo, hi, err = BoundingRange(us)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lo != us[1] || hi != us[2] {
+		t.Errorf("multiple elements: got %s, %s; want %s, %s", lo, hi, us[1], us[2])
+	}
+}
+
+func TestBoundingRangeErrors(t *testing.T) {
+	if _, _, err := BoundingRange(nil); err == nil {
+		t.Error("empty input: expected error")
+	}
+	if _, _, err := BoundingRange([]UUID{testUUID, "bad"}); err == nil {
+		t.Error("invalid element: expected error")
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	us := randomUUIDs(t, 30)
+	slices.Sort(us)
+	a := []UUID{us[0], us[3], us[5], us[10], us[20]}
+	b := []UUID{us[1], us[3], us[10], us[11], us[29]}
+	c := []UUID{us[2], us[5], us[20], us[29]}
+	got := MergeSorted(a, b, c, nil)
+	want := []UUID{us[0], us[1], us[2], us[3], us[5], us[10], us[11], us[20], us[29]}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeSorted() = %v, want %v", got, want)
+	}
+	if got := MergeSorted(); len(got) != 0 {
+		t.Errorf("MergeSorted() with no streams = %v, want empty", got)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	us := randomUUIDs(t, 9)
+	sorted := slices.Clone(us)
+	slices.Sort(sorted)
+	tests := []struct {
+		p    float64
+		want UUID
+	}{
+		{0, sorted[0]},
+		{50, sorted[4]},
+		{100, sorted[8]},
+	}
+	for _, tt := range tests {
+		got, err := Percentile(us, tt.p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Percentile(us, %v) = %s, want %s", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestPercentileErrors(t *testing.T) {
+	if _, err := Percentile(nil, 50); err == nil {
+		t.Error("empty input: expected error")
+	}
+	us := randomUUIDs(t, 3)
+	for _, p := range []float64{-1, 100.5} {
+		if _, err := Percentile(us, p); err == nil {
+			t.Errorf("Percentile(us, %v): expected error", p)
+		}
+	}
+	if _, err := Percentile([]UUID{"not-a-uuid"}, 50); err == nil {
+		t.Error("invalid element: expected error")
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	us := randomUUIDs(t, 8)
+	slices.Sort(us)
+	reversed := slices.Clone(us)
+	slices.Reverse(reversed)
+	dups := []UUID{us[0], us[1], us[1], UUID(strings.ToUpper(string(us[1]))), us[2]}
+	tests := []struct {
+		name string
+		us   []UUID
+		want bool
+	}{
+		{"sorted", us, true},
+		{"reversed", reversed, false},
+		{"duplicates", dups, true},
+		{"unsorted duplicates", []UUID{us[1], us[1], us[0]}, false},
+		{"invalid", []UUID{us[0], "not-a-uuid"}, false},
+		{"single", us[:1], true},
+		{"empty", nil, true},
+	}
+	for _, tt := range tests {
+		if got := IsSorted(tt.us); got != tt.want {
+			t.Errorf("%s: IsSorted() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCompareMixed(t *testing.T) {
+	v7 := v7At(t, time.Now())
+	v5, err := NamespaceDNS.Child("www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := append([]UUID{
+		v7, v5, testUUID,
+		"00000000-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}, randomUUIDs(t, 4)...)
+	cmp := func(a, b UUID) int {
+		t.Helper()
+		c, err := CompareMixed(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	if c := cmp("2ed6657d-e927-568b-95e1-2665a8aea6a2", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"); c != -1 {
+		t.Errorf("v5 vs v1: CompareMixed() = %d, want -1", c)
+	}
+	for _, a := range set {
+		if c := cmp(a, a); c != 0 {
+			t.Errorf("CompareMixed(%s, itself) = %d", a, c)
+		}
+		for _, b := range set {
+			if cmp(a, b) != -cmp(b, a) {
+				t.Errorf("CompareMixed(%s, %s) is not antisymmetric", a, b)
+			}
+			for _, c := range set {
+				if cmp(a, b) < 0 && cmp(b, c) < 0 && cmp(a, c) >= 0 {
+					t.Errorf("not transitive: %s < %s < %s", a, b, c)
+				}
+			}
+		}
+	}
+	if _, err := CompareMixed(testUUID, "not-a-uuid"); err == nil {
+		t.Error("invalid operand: expected error")
+	}
+}
+
+func TestGaps(t *testing.T) {
+	even := Palette(8)
+	got, err := Gaps(even)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := slices.Repeat([]uint64{1 << 61}, 7)
+	if !slices.Equal(got, want) {
+		t.Errorf("evenly spaced: Gaps() = %v, want %v", got, want)
+	}
+	uneven := []UUID{
+		"00000000-0000-0000-0000-000000000000",
+		"00000000-0000-0001-0000-000000000000",
+		"00000000-0000-0001-ffff-ffffffffffff",
+		"00000001-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	}
+	got, err = Gaps(uneven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []uint64{1, 0, 1<<32 - 1, 1<<64 - 1<<32 - 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("unevenly spaced: Gaps() = %v, want %v", got, want)
+	}
+	if got, err := Gaps(even[:1]); err != nil || len(got) != 0 {
+		t.Errorf("single element: Gaps() = %v, %v; want empty", got, err)
+	}
+}
+
+func TestGapsErrors(t *testing.T) {
+	even := Palette(3)
+	if _, err := Gaps([]UUID{even[1], even[0]}); err == nil {
+		t.Error("unsorted input: expected error")
+	}
+	if _, err := Gaps([]UUID{even[0], "not-a-uuid"}); err == nil {
+		t.Error("invalid element: expected error")
+	}
+}
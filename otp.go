@@ -0,0 +1,81 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// otpSeedKey is the fixed HMAC key used by OTPSeed.
+const otpSeedKey = "github.com/aatuh/uuid OTPSeed"
+
+// OTPSeed derives a stable 20-byte TOTP shared secret from the UUID, for
+// giving each entity a reproducible OTP secret in a test harness. The seed
+// is the first 20 bytes of HMAC-SHA256, keyed with a fixed package
+// constant, over the UUID's 16 bytes, so the same UUID always yields the
+// same seed and 20 bytes matches the HMAC-SHA1 key size of RFC 6238.
+//
+// The seed is only for tests and development. Anyone who knows the UUID
+// can compute it, so it must never stand in for a secret generated with
+// crypto/rand.
+//
+// Returns:
+//   - []byte: A freshly allocated 20-byte seed.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) OTPSeed() ([]byte, error) {
+	b, err := decode(u)
+	if err != nil {
+		return nil, fmt.Errorf("OTPSeed: %w", err)
+	}
+	mac := hmac.New(sha256.New, []byte(otpSeedKey))
+	mac.Write(b[:])
+	return mac.Sum(nil)[:20], nil
+}
+
+// SMSCode derives an HOTP-like decimal verification code from the UUID and
+// a counter, for SMS flows in which the UUID acts as a shared secret known
+// only to the server and the party being verified. The code is HMAC-SHA256,
+// keyed with the UUID's 16 bytes, over the counter and a block index as
+// big-endian uint64 and uint32. Each 8-byte word of the digest is read as a
+// big-endian uint64 and rejected if it falls in the final partial range of
+// 10^digits values, so the reduction modulo 10^digits has no bias; further
+// blocks are computed in the vanishingly rare case that every word is
+// rejected. The same UUID and counter always yield the same code.
+//
+// Parameters:
+//   - counter: The moving factor; increment it for each new code.
+//   - digits: The code length, in [1, 19].
+//
+// Returns:
+//   - string: The zero-padded code of exactly digits decimal digits.
+//   - error: An error if digits is out of range or the receiver is not a
+//     valid UUID.
+func (u UUID) SMSCode(counter uint64, digits int) (string, error) {
+	if digits < 1 || digits > 19 {
+		return "", fmt.Errorf("SMSCode: digits must be in [1, 19]: %d", digits)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("SMSCode: %w", err)
+	}
+	mod := uint64(1)
+	for range digits {
+		mod *= 10
+	}
+	limit := math.MaxUint64 - math.MaxUint64%mod
+	var msg [12]byte
+	binary.BigEndian.PutUint64(msg[0:8], counter)
+	for block := uint32(0); ; block++ {
+		binary.BigEndian.PutUint32(msg[8:12], block)
+		mac := hmac.New(sha256.New, b[:])
+		mac.Write(msg[:])
+		sum := mac.Sum(nil)
+		for i := 0; i < len(sum); i += 8 {
+			if x := binary.BigEndian.Uint64(sum[i : i+8]); x < limit {
+				return fmt.Sprintf("%0*d", digits, x%mod), nil
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package uuid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestOTPSeedDeterministic(t *testing.T) {
+	a, err := testUUID.OTPSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := UUID(strings.ToUpper(string(testUUID))).OTPSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 20 {
+		t.Fatalf("OTPSeed() has %d bytes, want 20", len(a))
+	}
+	if !bytes.Equal(a, b) {
+		t.Errorf("OTPSeed() not deterministic: %x != %x", a, b)
+	}
+	a[0] ^= 0xff
+	c, _ := testUUID.OTPSeed()
+	if !bytes.Equal(b, c) {
+		t.Error("modifying a returned seed changed a later one")
+	}
+	other, _ := UUID("6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6e").OTPSeed()
+	if bytes.Equal(other, c) {
+		t.Error("different UUIDs yield the same seed")
+	}
+	if _, err := UUID("not-a-uuid").OTPSeed(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestSMSCode(t *testing.T) {
+	a, err := testUUID.SMSCode(7, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 6 || strings.Trim(a, "0123456789") != "" {
+		t.Fatalf("SMSCode() = %q, want 6 decimal digits", a)
+	}
+	upper := UUID(strings.ToUpper(string(testUUID)))
+	if b, _ := upper.SMSCode(7, 6); b != a {
+		t.Errorf("SMSCode() not stable across casing: %s != %s", b, a)
+	}
+	seen := make(map[string]bool)
+	for counter := range uint64(100) {
+		c, err := testUUID.SMSCode(counter, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen[c] = true
+	}
+	if len(seen) < 95 {
+		t.Errorf("100 counters produced only %d distinct codes", len(seen))
+	}
+	for _, digits := range []int{1, 19} {
+		c, err := testUUID.SMSCode(0, digits)
+		if err != nil || len(c) != digits {
+			t.Errorf("SMSCode(0, %d) = %q, %v", digits, c, err)
+		}
+	}
+}
+
+func TestSMSCodeDistribution(t *testing.T) {
+	const n = 10000
+	var counts [10]int
+	for counter := range uint64(n) {
+		c, err := testUUID.SMSCode(counter, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[c[0]-'0']++
+	}
+	// Each digit expects 1000 with a standard deviation of 30.
+	for d, c := range counts {
+		if c < 850 || c > 1150 {
+			t.Errorf("digit %d drawn %d of %d times: %v", d, c, n, counts)
+			break
+		}
+	}
+}
+
+func TestSMSCodeErrors(t *testing.T) {
+	for _, digits := range []int{0, -1, 20} {
+		if _, err := testUUID.SMSCode(0, digits); err == nil {
+			t.Errorf("SMSCode(0, %d): expected error", digits)
+		}
+	}
+	if _, err := UUID("not-a-uuid").SMSCode(0, 6); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
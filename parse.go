@@ -0,0 +1,140 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// urnPrefix is the RFC 4122 URN namespace prefix.
+const urnPrefix = "urn:uuid:"
+
+// decodeCompact decodes 32 hex digits without hyphens into 16 raw bytes.
+func decodeCompact(s string) ([16]byte, error) {
+	var b [16]byte
+	if len(s) != 32 {
+		return b, fmt.Errorf("expected string length of 32 for compact UUID: %s", s)
+	}
+	for i := range b {
+		hi, ok1 := fromHexChar(s[2*i])
+		lo, ok2 := fromHexChar(s[2*i+1])
+		if !ok1 || !ok2 {
+			return b, fmt.Errorf("invalid UUID input: %s", s)
+		}
+		b[i] = hi<<4 | lo
+	}
+	return b, nil
+}
+
+// decodeLenient decodes the canonical, compact, braced ("{...}") and URN
+// ("urn:uuid:...") forms of a UUID of any version into 16 raw bytes.
+func decodeLenient(s string) ([16]byte, error) {
+	switch {
+	case len(s) == 32:
+		return decodeCompact(s)
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		return decode(UUID(s[1:37]))
+	case len(s) == 45 && strings.EqualFold(s[:len(urnPrefix)], urnPrefix):
+		return decode(UUID(s[len(urnPrefix):]))
+	}
+	return decode(UUID(s))
+}
+
+// EqualString reports whether s denotes the same UUID as the receiver. The
+// comparison is case-insensitive and s may be in canonical, braced, URN or
+// compact form. Both sides are compared as raw bytes, so no canonical
+// string is built.
+//
+// Parameters:
+//   - s: The string to compare against.
+//
+// Returns:
+//   - bool: True if s is a valid UUID equal to the receiver.
+func (u UUID) EqualString(s string) bool {
+	a, err := decode(u)
+	if err != nil {
+		return false
+	}
+	b, err := decodeLenient(s)
+	if err != nil {
+		return false
+	}
+	return a == b
+}
+
+// SplitSuffix splits a reference such as "<uuid>@v2" or "<uuid>#tag" into
+// its leading canonical UUID and the suffix after the '@' or '#' delimiter.
+// A bare UUID yields an empty suffix.
+//
+// Parameters:
+//   - s: The reference to split.
+//
+// Returns:
+//   - UUID: The leading UUID in lowercase canonical form.
+//   - string: The suffix without its delimiter.
+//   - error: An error if s does not start with a valid UUID, or the UUID is
+//     followed by anything other than '@' or '#'.
+func SplitSuffix(s string) (UUID, string, error) {
+	if len(s) < 36 {
+		return "", "", fmt.Errorf("SplitSuffix: invalid UUID reference: %s", s)
+	}
+	b, err := decode(UUID(s[:36]))
+	if err != nil {
+		return "", "", fmt.Errorf("SplitSuffix: %w", err)
+	}
+	rest := s[36:]
+	if rest != "" && rest[0] != '@' && rest[0] != '#' {
+		return "", "", fmt.Errorf("SplitSuffix: invalid suffix delimiter: %s", s)
+	}
+	if rest != "" {
+		rest = rest[1:]
+	}
+	return encode(b), rest, nil
+}
+
+// LookupCanonical canonicalizes s to lowercase hyphenated form and looks it
+// up in m, whose keys are assumed to be canonical. s may be in canonical,
+// braced, URN or compact form in any case, which avoids misses caused by
+// inconsistent casing.
+//
+// Parameters:
+//   - m: The map to look up, keyed by canonical UUIDs.
+//   - s: The key to look up.
+//
+// Returns:
+//   - V: The value stored under the canonical key, or the zero value.
+//   - bool: True if the key is present.
+//   - error: An error if s is not a valid UUID.
+func LookupCanonical[V any](m map[UUID]V, s string) (V, bool, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		var none V
+		return none, false, fmt.Errorf("LookupCanonical: %w", err)
+	}
+	v, ok := m[encode(b)]
+	return v, ok, nil
+}
+
+// ParseArgs validates command-line arguments as UUIDs in canonical, braced,
+// URN or compact form, in any case, and returns them in lowercase canonical
+// form. Empty args yield an empty slice.
+//
+// Parameters:
+//   - args: The arguments to parse, e.g. flag.Args().
+//
+// Returns:
+//   - []UUID: The parsed UUIDs in argument order.
+//   - error: An error naming the index and value of the first invalid
+//     argument.
+func ParseArgs(args []string) ([]UUID, error) {
+	out := make([]UUID, 0, len(args))
+	for i, a := range args {
+		b, err := decodeLenient(a)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"ParseArgs: argument %d (%q) is not a valid UUID", i, a,
+			)
+		}
+		out = append(out, encode(b))
+	}
+	return out, nil
+}
@@ -0,0 +1,152 @@
+package uuid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEqualString(t *testing.T) {
+	compact := strings.ReplaceAll(string(testUUID), "-", "")
+	equal := []string{
+		string(testUUID),
+		strings.ToUpper(string(testUUID)),
+		"{" + string(testUUID) + "}",
+		"urn:uuid:" + string(testUUID),
+		compact,
+		strings.ToUpper(compact),
+	}
+	for _, s := range equal {
+		if !testUUID.EqualString(s) {
+			t.Errorf("EqualString(%q) = false, want true", s)
+		}
+	}
+	notEqual := []string{
+		"",
+		"not-a-uuid",
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6e",
+		string(testUUID) + "0",
+	}
+	for _, s := range notEqual {
+		if testUUID.EqualString(s) {
+			t.Errorf("EqualString(%q) = true, want false", s)
+		}
+	}
+	if UUID("not-a-uuid").EqualString("not-a-uuid") {
+		t.Error("invalid receiver: EqualString = true, want false")
+	}
+}
+
+func TestEqualStringAllocs(t *testing.T) {
+	s := "URN:UUID:" + strings.ToUpper(string(testUUID))
+	allocs := testing.AllocsPerRun(100, func() {
+		testUUID.EqualString(s)
+	})
+	if allocs != 0 {
+		t.Errorf("EqualString allocated %v times per call, want 0", allocs)
+	}
+}
+
+func TestSplitSuffix(t *testing.T) {
+	upper := strings.ToUpper(string(testUUID))
+	tests := []struct {
+		in     string
+		suffix string
+	}{
+		{string(testUUID), ""},
+		{upper, ""},
+		{string(testUUID) + "@v2", "v2"},
+		{upper + "#tag", "tag"},
+		{string(testUUID) + "@", ""},
+		{string(testUUID) + "#a@b", "a@b"},
+	}
+	for _, tt := range tests {
+		u, suffix, err := SplitSuffix(tt.in)
+		if err != nil {
+			t.Fatalf("SplitSuffix(%q): %v", tt.in, err)
+		}
+		if u != testUUID || suffix != tt.suffix {
+			t.Errorf("SplitSuffix(%q) = %s, %q; want %s, %q", tt.in, u, suffix, testUUID, tt.suffix)
+		}
+	}
+}
+
+func TestSplitSuffixErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"@v2",
+		"not-a-uuid@v2",
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5cXX@v2",
+		strings.ReplaceAll(string(testUUID), "-", "") + "@v2",
+		string(testUUID) + "v2",
+		string(testUUID) + "/v2",
+	} {
+		if _, _, err := SplitSuffix(in); err == nil {
+			t.Errorf("SplitSuffix(%q): expected error", in)
+		}
+	}
+}
+
+func TestLookupCanonical(t *testing.T) {
+	m := map[UUID]int{testUUID: 42}
+	for _, s := range []string{
+		string(testUUID),
+		strings.ToUpper(string(testUUID)),
+		"{" + strings.ToUpper(string(testUUID)) + "}",
+		"urn:uuid:6F1A0b1C-8d7E-4a2B-8c9D-1e2F3a4B5c6D",
+	} {
+		v, ok, err := LookupCanonical(m, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok || v != 42 {
+			t.Errorf("LookupCanonical(%q) = %d, %v; want 42, true", s, v, ok)
+		}
+	}
+	v, ok, err := LookupCanonical(m, "6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6E")
+	if err != nil || ok || v != 0 {
+		t.Errorf("missing key: LookupCanonical() = %d, %v, %v; want 0, false, nil", v, ok, err)
+	}
+	if _, _, err := LookupCanonical(m, "not-a-uuid"); err == nil {
+		t.Error("invalid input: expected error")
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	got, err := ParseArgs([]string{
+		string(testUUID),
+		strings.ToUpper(string(testUUID)),
+		"urn:uuid:" + string(testUUID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []UUID{testUUID, testUUID, testUUID}; !slices.Equal(got, want) {
+		t.Errorf("ParseArgs() = %v, want %v", got, want)
+	}
+	got, err = ParseArgs(nil)
+	if err != nil || got == nil || len(got) != 0 {
+		t.Errorf("ParseArgs(nil) = %#v, %v; want an empty slice", got, err)
+	}
+}
+
+func TestParseArgsErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"bogus"}, `argument 0 ("bogus")`},
+		{[]string{string(testUUID), "", string(testUUID)}, `argument 1 ("")`},
+		{[]string{string(testUUID), string(testUUID), "123"}, `argument 2 ("123")`},
+	}
+	for _, tt := range tests {
+		got, err := ParseArgs(tt.args)
+		if err == nil || got != nil {
+			t.Errorf("ParseArgs(%q) = %v, %v; want an error", tt.args, got, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseArgs(%q) error %q does not mention %s", tt.args, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParsePGArray parses a PostgreSQL uuid[] array literal such as
+// "{uuid1,uuid2}" as returned when the column is read as text. Elements may
+// be double-quoted and surrounded by whitespace. Each element is validated
+// and returned in lowercase canonical form. The empty literal "{}" yields an
+// empty slice.
+//
+// Parameters:
+//   - s: The array literal to parse.
+//
+// Returns:
+//   - []UUID: The parsed UUIDs in array order.
+//   - error: An error if the literal or any element is invalid.
+func ParsePGArray(s string) ([]UUID, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("ParsePGArray: invalid array literal: %s", s)
+	}
+	body := strings.TrimSpace(s[1 : len(s)-1])
+	if body == "" {
+		return []UUID{}, nil
+	}
+	parts := strings.Split(body, ",")
+	out := make([]UUID, 0, len(parts))
+	for i, p := range parts {
+		elem := strings.Trim(strings.TrimSpace(p), `"`)
+		b, err := decode(UUID(strings.TrimSpace(elem)))
+		if err != nil {
+			return nil, fmt.Errorf("ParsePGArray: element %d: %w", i, err)
+		}
+		out = append(out, encode(b))
+	}
+	return out, nil
+}
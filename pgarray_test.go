@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParsePGArray(t *testing.T) {
+	a := UUID("6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d")
+	b := UUID("0a1b2c3d-4e5f-4a6b-9c7d-8e9f0a1b2c3d")
+	tests := []struct {
+		name string
+		in   string
+		want []UUID
+	}{
+		{"empty", "{}", []UUID{}},
+		{"unquoted", "{" + string(a) + "," + string(b) + "}", []UUID{a, b}},
+		{"quoted", `{"` + string(a) + `","` + string(b) + `"}`, []UUID{a, b}},
+		{"mixed and spaced", ` { "` + string(a) + `" , ` + string(b) + ` } `, []UUID{a, b}},
+		{"uppercase", "{6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6D}", []UUID{a}},
+	}
+	for _, tt := range tests {
+		got, err := ParsePGArray(tt.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParsePGArrayInvalid(t *testing.T) {
+	for _, in := range []string{"", "{", string(testUUID), "{" + string(testUUID) + ",x}", "{,}"} {
+		if _, err := ParsePGArray(in); err == nil {
+			t.Errorf("ParsePGArray(%q): expected error", in)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPrefixMismatch is returned by FromPrefixedID when an ID carries a
+// different prefix than expected.
+var ErrPrefixMismatch = errors.New("ID prefix mismatch")
+
+// PrefixedID returns a Stripe-style prefixed ID of the form
+// "<prefix>_<base58>", where the suffix is the Bitcoin Base58 encoding of
+// the UUID's 16 bytes (at most 22 characters). Since Base58 never contains
+// '_', the prefix itself may.
+//
+// Parameters:
+//   - prefix: The type-indicating prefix, e.g. "usr". Must not be empty.
+//
+// Returns:
+//   - string: The prefixed ID.
+//   - error: An error if prefix is empty or the receiver is not a valid
+//     UUID.
+func (u UUID) PrefixedID(prefix string) (string, error) {
+	if prefix == "" {
+		return "", errors.New("PrefixedID: empty prefix")
+	}
+	b, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("PrefixedID: %w", err)
+	}
+	return prefix + "_" + encodeBase58(b), nil
+}
+
+// FromPrefixedID parses an ID produced by PrefixedID, checking that its
+// prefix is expectedPrefix.
+//
+// Parameters:
+//   - expectedPrefix: The prefix the ID must carry.
+//   - s: The prefixed ID.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error wrapping ErrPrefixMismatch if the prefix differs, or
+//     an error if s is malformed.
+func FromPrefixedID(expectedPrefix, s string) (UUID, error) {
+	i := strings.LastIndexByte(s, '_')
+	if i < 0 {
+		return "", fmt.Errorf("FromPrefixedID: missing prefix separator: %s", s)
+	}
+	if s[:i] != expectedPrefix {
+		return "", fmt.Errorf(
+			"FromPrefixedID: %w: want %q, got %q", ErrPrefixMismatch,
+			expectedPrefix, s[:i],
+		)
+	}
+	b, ok := decodeBase58(s[i+1:])
+	if !ok {
+		return "", fmt.Errorf("FromPrefixedID: invalid Base58 UUID: %s", s)
+	}
+	return encode(b), nil
+}
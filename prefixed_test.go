@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPrefixedIDRoundTrip(t *testing.T) {
+	us := append(randomUUIDs(t, 5),
+		"00000000-0000-0000-0000-000000000000",
+		"00000000-0000-0000-0000-0000000000ff",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	)
+	for _, prefix := range []string{"usr", "org", "tenant_usr", "x"} {
+		for _, u := range us {
+			id, err := u.PrefixedID(prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(id, prefix+"_") {
+				t.Errorf("PrefixedID(%q) = %q, missing prefix", prefix, id)
+			}
+			got, err := FromPrefixedID(prefix, id)
+			if err != nil {
+				t.Fatalf("FromPrefixedID(%q, %q): %v", prefix, id, err)
+			}
+			if got != u {
+				t.Errorf("FromPrefixedID(%q, %q) = %s, want %s", prefix, id, got, u)
+			}
+		}
+	}
+}
+
+func TestFromPrefixedIDErrors(t *testing.T) {
+	id, err := testUUID.PrefixedID("usr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FromPrefixedID("org", id); !errors.Is(err, ErrPrefixMismatch) {
+		t.Errorf("mismatched prefix: error = %v, want ErrPrefixMismatch", err)
+	}
+	for _, s := range []string{"usr", "usr_", "usr_0OIl", "usr_" + strings.Repeat("z", 30)} {
+		_, err := FromPrefixedID("usr", s)
+		if err == nil || errors.Is(err, ErrPrefixMismatch) {
+			t.Errorf("FromPrefixedID(%q) error = %v, want a decoding error", s, err)
+		}
+	}
+	if _, err := testUUID.PrefixedID(""); err == nil {
+		t.Error("empty prefix: expected error")
+	}
+}
@@ -0,0 +1,97 @@
+package uuid
+
+import (
+	"fmt"
+	"math"
+)
+
+// Weights of the components of QualityScore. They sum to 1.
+const (
+	qualityWeightValidity = 0.4
+	qualityWeightCasing   = 0.2
+	qualityWeightFormat   = 0.2
+	qualityWeightEntropy  = 0.2
+)
+
+// QualityScore parses s, in canonical, braced, URN or compact form, and
+// rates how clean it is as a score in [0, 1], for ranking batches of IDs on
+// a data-quality dashboard. The score is the weighted sum of four
+// components, each in [0, 1]:
+//   - validity (weight 0.4): 1 for an RFC 9562 Variant 1 UUID with a defined
+//     version (1 to 8), 0 otherwise.
+//   - casing (weight 0.2): the fraction of hex letters that are lowercase,
+//     or 1 if there are none.
+//   - format (weight 0.2): 1 for the plain hyphenated form, 0.5 for the
+//     braced, URN and compact forms.
+//   - entropy (weight 0.2): the Shannon entropy of the 30 hex digits other
+//     than the version and variant digits, divided by its 4-bit maximum.
+//
+// With only 30 digits the entropy estimate of truly random data averages
+// about 0.9, so a canonical random UUID typically scores about 0.98,
+// while repetitive digits, such as an all-zero body, pull the score down.
+//
+// Parameters:
+//   - s: The string to rate.
+//
+// Returns:
+//   - float64: The quality score.
+//   - error: An error if s cannot be parsed as a UUID at all.
+func QualityScore(s string) (float64, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return 0, fmt.Errorf("QualityScore: %w", err)
+	}
+	score := 0.0
+	if v := b[6] >> 4; v >= 1 && v <= 8 && b[8]&0xc0 == 0x80 {
+		score += qualityWeightValidity
+	}
+
+	body, format := s, 1.0
+	switch {
+	case len(s) == 32:
+		format = 0.5
+	case len(s) == 38:
+		body, format = s[1:37], 0.5
+	case len(s) == 45:
+		body, format = s[len(urnPrefix):], 0.5
+	}
+	score += qualityWeightFormat * format
+
+	lower, upper := 0, 0
+	for i := 0; i < len(body); i++ {
+		switch c := body[i]; {
+		case c >= 'a' && c <= 'f':
+			lower++
+		case c >= 'A' && c <= 'F':
+			upper++
+		}
+	}
+	if lower+upper == 0 {
+		score += qualityWeightCasing
+	} else {
+		score += qualityWeightCasing * float64(lower) / float64(lower+upper)
+	}
+
+	var counts [16]int
+	n := 0
+	for i := range 32 {
+		if i == 12 || i == 16 {
+			continue
+		}
+		nibble := b[i/2] >> 4
+		if i%2 == 1 {
+			nibble = b[i/2] & 0x0f
+		}
+		counts[nibble]++
+		n++
+	}
+	entropy := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / float64(n)
+			entropy -= p * math.Log2(p)
+		}
+	}
+	score += qualityWeightEntropy * entropy / 4
+	return score, nil
+}
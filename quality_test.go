@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestQualityScore(t *testing.T) {
+	score := func(s string) float64 {
+		t.Helper()
+		q, err := QualityScore(s)
+		if err != nil {
+			t.Fatalf("QualityScore(%q): %v", s, err)
+		}
+		if q < 0 || q > 1 {
+			t.Fatalf("QualityScore(%q) = %v, out of [0, 1]", s, q)
+		}
+		return q
+	}
+	total := 0.0
+	us := randomUUIDs(t, 100)
+	for _, u := range us {
+		total += score(string(u))
+	}
+	if mean := total / float64(len(us)); mean < 0.96 {
+		t.Errorf("mean score of random canonical UUIDs = %v, want about 0.98", mean)
+	}
+	pristine := score(string(testUUID))
+	messier := []string{
+		strings.ToUpper(string(testUUID)),
+		"{" + string(testUUID) + "}",
+		"urn:uuid:" + string(testUUID),
+		strings.ReplaceAll(string(testUUID), "-", ""),
+		"6f1a0b1c-8d7e-0a2b-8c9d-1e2f3a4b5c6d",
+		"00000000-0000-4000-8000-000000000000",
+	}
+	for _, s := range messier {
+		if q := score(s); q >= pristine {
+			t.Errorf("QualityScore(%q) = %v, not below the pristine %v", s, q, pristine)
+		}
+	}
+	// Braced costs half the format weight and uppercase the whole casing
+	// weight: 0.1 + 0.2.
+	if q := score(strings.ToUpper("{" + string(testUUID) + "}")); math.Abs(pristine-0.3-q) > 1e-9 {
+		t.Errorf("braced uppercase score %v, want %v", q, pristine-0.3)
+	}
+}
+
+func TestQualityScoreUnparseable(t *testing.T) {
+	for _, s := range []string{"", "not-a-uuid", string(testUUID)[:35]} {
+		if _, err := QualityScore(s); err == nil {
+			t.Errorf("QualityScore(%q): expected error", s)
+		}
+	}
+}
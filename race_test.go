@@ -0,0 +1,7 @@
+//go:build race
+
+package uuid
+
+// raceEnabled reports whether the race detector is on, which adds
+// allocations that tests counting them must skip.
+const raceEnabled = true
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimitedGenerator caps how fast UUIDs are minted by an underlying
+// generator using a token bucket: tokens accrue at a fixed rate up to a
+// burst size, and each UUID consumes one. A single generator may be shared
+// by multiple goroutines; callers are served in the order they reserve
+// tokens.
+type RateLimitedGenerator struct {
+	gen    func() (UUID, error)
+	limit  float64
+	burst  float64
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimitedGenerator returns a RateLimitedGenerator that mints UUIDs
+// with gen, e.g. Ver4Var1, at most perSecond times per second on average
+// and at most burst times in immediate succession. The bucket starts full.
+//
+// Parameters:
+//   - gen: The underlying generator.
+//   - perSecond: The sustained rate in UUIDs per second. Must be positive.
+//   - burst: The bucket capacity. Must be positive.
+//
+// Returns:
+//   - *RateLimitedGenerator: The generator.
+//   - error: An error if gen is nil or perSecond or burst is not positive.
+func NewRateLimitedGenerator(
+	gen func() (UUID, error), perSecond float64, burst int,
+) (*RateLimitedGenerator, error) {
+	if gen == nil {
+		return nil, errors.New("NewRateLimitedGenerator: nil generator")
+	}
+	if !(perSecond > 0) {
+		return nil, fmt.Errorf(
+			"NewRateLimitedGenerator: rate must be positive: %g", perSecond,
+		)
+	}
+	if burst <= 0 {
+		return nil, fmt.Errorf(
+			"NewRateLimitedGenerator: burst must be positive: %d", burst,
+		)
+	}
+	return &RateLimitedGenerator{
+		gen:    gen,
+		limit:  perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}, nil
+}
+
+// Next waits until a token is available and then mints a UUID. If ctx is
+// done first, the reserved token is returned to the bucket and ctx's error
+// is returned.
+//
+// Parameters:
+//   - ctx: The context bounding the wait.
+//
+// Returns:
+//   - UUID: The minted UUID.
+//   - error: An error if ctx is done before a token is available or the
+//     underlying generator fails.
+func (g *RateLimitedGenerator) Next(ctx context.Context) (UUID, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("RateLimitedGenerator.Next: %w", err)
+	}
+	if wait := g.reserve(time.Now()); wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			g.mu.Lock()
+			g.tokens++
+			g.mu.Unlock()
+			return "", fmt.Errorf("RateLimitedGenerator.Next: %w", ctx.Err())
+		case <-t.C:
+		}
+	}
+	u, err := g.gen()
+	if err != nil {
+		return "", fmt.Errorf("RateLimitedGenerator.Next: %w", err)
+	}
+	return u, nil
+}
+
+// reserve takes one token, refilling the bucket up to now, and returns how
+// long the caller must wait until that token has accrued.
+func (g *RateLimitedGenerator) reserve(now time.Time) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if now.After(g.last) {
+		g.tokens += now.Sub(g.last).Seconds() * g.limit
+		g.tokens = min(g.tokens, g.burst)
+		g.last = now
+	}
+	g.tokens--
+	if g.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-g.tokens / g.limit * float64(time.Second))
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitedGeneratorRate(t *testing.T) {
+	const perSecond, burst, workers, each = 200, 5, 3, 15
+	g, err := NewRateLimitedGenerator(Ver4Var1, perSecond, burst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for range each {
+				if _, err := g.Next(t.Context()); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+	// The first burst UUIDs are free; the rest accrue at perSecond.
+	minElapsed := time.Duration(workers*each-burst) * time.Second / perSecond
+	if elapsed < minElapsed-10*time.Millisecond {
+		t.Errorf("minted %d UUIDs in %s, faster than the %s the limit allows",
+			workers*each, elapsed, minElapsed)
+	}
+}
+
+func TestRateLimitedGeneratorCancel(t *testing.T) {
+	g, err := NewRateLimitedGenerator(Ver4Var1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Next(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := g.Next(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Next() error = %v, want context.DeadlineExceeded", err)
+	}
+	done, cancelDone := context.WithCancel(t.Context())
+	cancelDone()
+	if _, err := g.Next(done); !errors.Is(err, context.Canceled) {
+		t.Errorf("Next() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestNewRateLimitedGeneratorErrors(t *testing.T) {
+	if _, err := NewRateLimitedGenerator(nil, 1, 1); err == nil {
+		t.Error("nil generator: expected error")
+	}
+	if _, err := NewRateLimitedGenerator(Ver4Var1, 0, 1); err == nil {
+		t.Error("zero rate: expected error")
+	}
+	if _, err := NewRateLimitedGenerator(Ver4Var1, 1, 0); err == nil {
+		t.Error("zero burst: expected error")
+	}
+}
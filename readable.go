@@ -0,0 +1,60 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// readableRhymes holds the hex letters whose English names rhyme ("bee",
+// "see", "dee", "ee") and are easily confused when read aloud.
+const readableRhymes = "bcde"
+
+// readableMaxAttempts caps how many candidates ReadableVer4 draws.
+const readableMaxAttempts = 1000
+
+// ReadableVer4 generates a random Version 4, Variant 1 UUID that avoids the
+// hex sequences most often misheard or miscounted when an ID is read aloud
+// or typed by hand. A candidate is regenerated if its canonical lowercase
+// form contains:
+//   - Two adjacent, different letters from "bcde", whose names rhyme.
+//   - Three or more identical characters in a row.
+//
+// About 30% of random UUIDs pass, so on average about 3.3 candidates are
+// drawn and the effective entropy drops by roughly 1.7 bits, from 122 to
+// about 120.3.
+//
+// Returns:
+//   - UUID: A random UUID free of the patterns above.
+//   - error: An error if crypto/rand fails or no candidate passes within
+//     1000 attempts.
+func ReadableVer4() (UUID, error) {
+	for range readableMaxAttempts {
+		b, err := random16()
+		if err != nil {
+			return "", fmt.Errorf("ReadableVer4: %w", err)
+		}
+		setVer4Var1(&b)
+		u := encode(b)
+		if isReadable(string(u)) {
+			return u, nil
+		}
+	}
+	return "", errors.New("ReadableVer4: no readable UUID found")
+}
+
+// isReadable reports whether s avoids the patterns rejected by
+// ReadableVer4.
+func isReadable(s string) bool {
+	for i := 0; i+1 < len(s); i++ {
+		a, b := s[i], s[i+1]
+		if a != b && strings.IndexByte(readableRhymes, a) >= 0 &&
+			strings.IndexByte(readableRhymes, b) >= 0 {
+			return false
+		}
+		if i+2 < len(s) && a == b && b == s[i+2] {
+			return false
+		}
+	}
+	return true
+}
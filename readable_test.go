@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestReadableVer4(t *testing.T) {
+	for range 200 {
+		u, err := ReadableVer4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) {
+			t.Fatalf("ReadableVer4() = %s, want a valid v4 UUID", u)
+		}
+		if !isReadable(string(u)) {
+			t.Fatalf("ReadableVer4() = %s contains an avoided pattern", u)
+		}
+	}
+}
+
+func TestIsReadable(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"a1b2c3", true},
+		{"bb", true},
+		{"1bc2", false},
+		{"1ed2", false},
+		{"1aaa2", false},
+		{"1aa2", true},
+	}
+	for _, tt := range tests {
+		if got := isReadable(tt.s); got != tt.want {
+			t.Errorf("isReadable(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVer4WithSecond(t *testing.T) {
+	var a, b UUID
+	var sa, sb int64
+	// Retry if the pair straddles a second boundary.
+	for range 5 {
+		before := time.Now().Unix()
+		var err error
+		if a, err = Ver4WithSecond(); err != nil {
+			t.Fatal(err)
+		}
+		if b, err = Ver4WithSecond(); err != nil {
+			t.Fatal(err)
+		}
+		after := time.Now().Unix()
+		sa, _ = a.Second()
+		sb, _ = b.Second()
+		if sa < before || sb > after {
+			t.Fatalf("Second() = %d, %d outside [%d, %d]", sa, sb, before, after)
+		}
+		if sa == sb {
+			break
+		}
+	}
+	if sa != sb {
+		t.Fatalf("could not mint two UUIDs in the same second")
+	}
+	if a[:8] != b[:8] {
+		t.Errorf("same-second UUIDs %s and %s do not share their first 8 digits", a, b)
+	}
+	if a == b {
+		t.Errorf("Ver4WithSecond() returned %s twice", a)
+	}
+	for _, u := range []UUID{a, b} {
+		if !isCanonical(string(u)) || u[14] != '4' || !strings.ContainsRune("89abAB", rune(u[19])) {
+			t.Errorf("%s is not a canonical Version 4, Variant 1 UUID", u)
+		}
+	}
+}
+
+func TestSecond(t *testing.T) {
+	got, err := UUID("665d6e40-8d7e-4a2b-8c9d-1e2f3a4b5c6d").Second()
+	if want := time.Date(2024, 6, 3, 7, 18, 24, 0, time.UTC).Unix(); err != nil || got != want {
+		t.Errorf("Second() = %d, %v; want %d", got, err, want)
+	}
+	if _, err := UUID("not-a-uuid").Second(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
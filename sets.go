@@ -0,0 +1,142 @@
+package uuid
+
+import "fmt"
+
+// Dedup returns us with duplicates removed, keeping each UUID at the
+// position of its first occurrence. UUIDs are compared by their 16 raw
+// bytes, so different casings of the same UUID are duplicates. Invalid
+// UUIDs are skipped.
+//
+// Parameters:
+//   - us: The UUIDs to deduplicate.
+//
+// Returns:
+//   - []UUID: The first occurrence of each distinct UUID, in input order.
+func Dedup(us []UUID) []UUID {
+	seen := make(map[[16]byte]struct{}, len(us))
+	out := make([]UUID, 0, len(us))
+	for _, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[b]; ok {
+			continue
+		}
+		seen[b] = struct{}{}
+		out = append(out, u)
+	}
+	return out
+}
+
+// Difference returns the UUIDs of current that are not present in previous,
+// in current's order. UUIDs are compared by their 16 raw bytes, so casing
+// does not matter. A single set is built from previous. Invalid UUIDs in
+// current are skipped and invalid UUIDs in previous are ignored; duplicates
+// within current are kept.
+//
+// Parameters:
+//   - current: The UUIDs to filter.
+//   - previous: The UUIDs to exclude.
+//
+// Returns:
+//   - []UUID: The UUIDs only present in current.
+func Difference(current, previous []UUID) []UUID {
+	exclude := keySet(previous)
+	out := make([]UUID, 0, len(current))
+	for _, u := range current {
+		b, err := decode(u)
+		if err != nil {
+			continue
+		}
+		if _, ok := exclude[b]; !ok {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// Union returns the distinct UUIDs across all sources, each at the position
+// of its first occurrence in the concatenation of sources, exactly as
+// Dedup would on that concatenation but without building it. UUIDs are
+// compared by their 16 raw bytes, so casing does not matter, and invalid
+// UUIDs are skipped. Empty and nil sources contribute nothing.
+//
+// Parameters:
+//   - sources: The collections to combine.
+//
+// Returns:
+//   - []UUID: The union, in first-seen order.
+func Union(sources ...[]UUID) []UUID {
+	total := 0
+	for _, us := range sources {
+		total += len(us)
+	}
+	seen := make(map[[16]byte]struct{}, total)
+	out := make([]UUID, 0, total)
+	for _, us := range sources {
+		for _, u := range us {
+			b, err := decode(u)
+			if err != nil {
+				continue
+			}
+			if _, ok := seen[b]; ok {
+				continue
+			}
+			seen[b] = struct{}{}
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// keySet returns the set of raw byte keys of the valid UUIDs in us.
+func keySet(us []UUID) map[[16]byte]struct{} {
+	set := make(map[[16]byte]struct{}, len(us))
+	for _, u := range us {
+		if b, err := decode(u); err == nil {
+			set[b] = struct{}{}
+		}
+	}
+	return set
+}
+
+// JaccardSimilarity returns |A∩B| / |A∪B| for the sets of distinct UUIDs in
+// a and b, compared by their 16 raw bytes so that casing does not matter.
+// Two empty sets are considered identical and yield 1.
+//
+// Parameters:
+//   - a: The first collection.
+//   - b: The second collection.
+//
+// Returns:
+//   - float64: The similarity in [0, 1].
+//   - error: An error if either collection contains an invalid UUID.
+func JaccardSimilarity(a, b []UUID) (float64, error) {
+	setA := make(map[[16]byte]struct{}, len(a))
+	for i, u := range a {
+		k, err := decode(u)
+		if err != nil {
+			return 0, fmt.Errorf("JaccardSimilarity: a[%d]: %w", i, err)
+		}
+		setA[k] = struct{}{}
+	}
+	setB := make(map[[16]byte]struct{}, len(b))
+	for i, u := range b {
+		k, err := decode(u)
+		if err != nil {
+			return 0, fmt.Errorf("JaccardSimilarity: b[%d]: %w", i, err)
+		}
+		setB[k] = struct{}{}
+	}
+	if len(setA) == 0 && len(setB) == 0 {
+		return 1, nil
+	}
+	common := 0
+	for k := range setB {
+		if _, ok := setA[k]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(len(setA)+len(setB)-common), nil
+}
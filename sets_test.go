@@ -0,0 +1,100 @@
+package uuid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDedup(t *testing.T) {
+	us := randomUUIDs(t, 3)
+	a, b, c := us[0], us[1], us[2]
+	in := []UUID{b, a, UUID(strings.ToUpper(string(b))), "not-a-uuid", c, a, b}
+	got := Dedup(in)
+	if want := []UUID{b, a, c}; !slices.Equal(got, want) {
+		t.Errorf("Dedup() = %v, want %v", got, want)
+	}
+	upperFirst := []UUID{UUID(strings.ToUpper(string(a))), a}
+	if got := Dedup(upperFirst); !slices.Equal(got, upperFirst[:1]) {
+		t.Errorf("Dedup() = %v, want the first occurrence as given", got)
+	}
+	if got := Dedup(nil); len(got) != 0 {
+		t.Errorf("Dedup(nil) = %v, want empty", got)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	us := randomUUIDs(t, 6)
+	current := []UUID{us[3], us[0], us[4], us[1], "not-a-uuid", us[5]}
+	previous := []UUID{us[1], UUID(strings.ToUpper(string(us[4]))), us[2]}
+	got := Difference(current, previous)
+	if want := []UUID{us[3], us[0], us[5]}; !slices.Equal(got, want) {
+		t.Errorf("overlapping: Difference() = %v, want %v", got, want)
+	}
+	disjoint := Difference(us[:3], us[3:])
+	if !slices.Equal(disjoint, us[:3]) {
+		t.Errorf("disjoint: Difference() = %v, want %v", disjoint, us[:3])
+	}
+	if got := Difference(us, us); len(got) != 0 {
+		t.Errorf("identical: Difference() = %v, want empty", got)
+	}
+	if got := Difference(us[:2], nil); !slices.Equal(got, us[:2]) {
+		t.Errorf("empty previous: Difference() = %v, want %v", got, us[:2])
+	}
+}
+
+func TestJaccardSimilarity(t *testing.T) {
+	us := randomUUIDs(t, 6)
+	upper := make([]UUID, 3)
+	for i := range upper {
+		upper[i] = UUID(strings.ToUpper(string(us[i])))
+	}
+	tests := []struct {
+		name string
+		a, b []UUID
+		want float64
+	}{
+		{"identical", us[:3], upper, 1},
+		{"disjoint", us[:3], us[3:], 0},
+		{"partial", us[:4], us[2:], 2.0 / 6},
+		{"duplicates", []UUID{us[0], us[0], us[1]}, []UUID{us[1]}, 0.5},
+		{"one empty", us[:2], nil, 0},
+		{"both empty", nil, nil, 1},
+	}
+	for _, tt := range tests {
+		got, err := JaccardSimilarity(tt.a, tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: JaccardSimilarity() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := JaccardSimilarity(us[:1], []UUID{"not-a-uuid"}); err == nil {
+		t.Error("invalid element: expected error")
+	}
+}
+
+func TestUnion(t *testing.T) {
+	us := randomUUIDs(t, 6)
+	a := []UUID{us[0], us[1], us[0]}
+	b := []UUID{UUID(strings.ToUpper(string(us[1]))), us[2], "not-a-uuid", us[3]}
+	c := []UUID{us[3], us[4], us[0], us[5]}
+	got := Union(a, nil, b, []UUID{}, c)
+	want := []UUID{us[0], us[1], us[2], us[3], us[4], us[5]}
+	if !slices.Equal(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if len(Dedup(got)) != len(got) {
+		t.Errorf("Union() contains duplicates: %v", got)
+	}
+	if concat := Dedup(slices.Concat(a, b, c)); !slices.Equal(got, concat) {
+		t.Errorf("Union() = %v, want Dedup of the concatenation %v", got, concat)
+	}
+	if got := Union(); len(got) != 0 {
+		t.Errorf("Union() with no sources = %v, want empty", got)
+	}
+	if got := Union(nil, nil); len(got) != 0 {
+		t.Errorf("Union(nil, nil) = %v, want empty", got)
+	}
+}
@@ -0,0 +1,134 @@
+package uuid
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+)
+
+// NibblePartition returns the partition of the UUID in a 16-way scheme keyed
+// on its first hex digit, i.e. the high nibble of its first byte.
+//
+// Returns:
+//   - int: The partition index in [0, 15].
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) NibblePartition() (int, error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, fmt.Errorf("NibblePartition: %w", err)
+	}
+	return int(b[0] >> 4), nil
+}
+
+// PartitionName returns the caller-supplied label of the UUID's
+// NibblePartition.
+//
+// Parameters:
+//   - names: The labels of partitions 0 through 15.
+//
+// Returns:
+//   - string: The label of the UUID's partition.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) PartitionName(names [16]string) (string, error) {
+	p, err := u.NibblePartition()
+	if err != nil {
+		return "", fmt.Errorf("PartitionName: %w", err)
+	}
+	return names[p], nil
+}
+
+// Replicas selects replicationFactor distinct nodes out of numNodes for the
+// UUID using rendezvous (highest-random-weight) hashing. Each node i is
+// weighted by the first 8 bytes of SHA-256 over the UUID's 16 bytes followed
+// by i as a big-endian uint64, and the heaviest nodes win. Adding or
+// removing a node only moves the UUIDs for which that node is, or becomes, a
+// winner.
+//
+// Parameters:
+//   - numNodes: The number of nodes, indexed 0 to numNodes-1.
+//   - replicationFactor: The number of nodes to select.
+//
+// Returns:
+//   - []int: The selected node indexes, heaviest first.
+//   - error: An error if the parameters are not positive, replicationFactor
+//     exceeds numNodes, or the receiver is not a valid UUID.
+func (u UUID) Replicas(numNodes, replicationFactor int) ([]int, error) {
+	if numNodes <= 0 || replicationFactor <= 0 {
+		return nil, fmt.Errorf(
+			"Replicas: node count and replication factor must be positive: %d, %d",
+			numNodes, replicationFactor,
+		)
+	}
+	if replicationFactor > numNodes {
+		return nil, fmt.Errorf(
+			"Replicas: replication factor %d exceeds node count %d",
+			replicationFactor, numNodes,
+		)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return nil, fmt.Errorf("Replicas: %w", err)
+	}
+	weights := make([]uint64, numNodes)
+	nodes := make([]int, numNodes)
+	var buf [24]byte
+	copy(buf[:16], b[:])
+	for i := range nodes {
+		binary.BigEndian.PutUint64(buf[16:], uint64(i))
+		sum := sha256.Sum256(buf[:])
+		weights[i] = binary.BigEndian.Uint64(sum[:8])
+		nodes[i] = i
+	}
+	slices.SortFunc(nodes, func(x, y int) int {
+		return cmp.Or(cmp.Compare(weights[y], weights[x]), cmp.Compare(x, y))
+	})
+	return nodes[:replicationFactor], nil
+}
+
+// BalancedSet returns a deterministic load-test workload of
+// numShards×perShard Version 4, Variant 1 UUIDs in which each shard, taken
+// as the first byte modulo numShards, receives exactly perShard UUIDs. The
+// j-th UUID of shard s is shaped from SHA-256 over numShards, s and j as
+// big-endian uint32s, with its first byte then replaced by a value
+// congruent to s, so UUIDs are spread within each shard. The result lists
+// shard 0's UUIDs first, then shard 1's, and so on.
+//
+// Parameters:
+//   - numShards: The number of shards, in [1, 256].
+//   - perShard: The number of UUIDs per shard. Must be positive.
+//
+// Returns:
+//   - []UUID: The UUIDs in lowercase canonical form.
+//   - error: An error if an argument is out of range.
+func BalancedSet(numShards, perShard int) ([]UUID, error) {
+	if numShards < 1 || numShards > 256 {
+		return nil, fmt.Errorf(
+			"BalancedSet: shard count must be in [1, 256]: %d", numShards,
+		)
+	}
+	if perShard <= 0 {
+		return nil, fmt.Errorf(
+			"BalancedSet: per-shard count must be positive: %d", perShard,
+		)
+	}
+	out := make([]UUID, 0, numShards*perShard)
+	var buf [12]byte
+	binary.BigEndian.PutUint32(buf[0:4], uint32(numShards))
+	for s := range numShards {
+		binary.BigEndian.PutUint32(buf[4:8], uint32(s))
+		// choices is the number of first-byte values congruent to s.
+		choices := (256 - s + numShards - 1) / numShards
+		for j := range perShard {
+			binary.BigEndian.PutUint32(buf[8:12], uint32(j))
+			sum := sha256.Sum256(buf[:])
+			var b [16]byte
+			copy(b[:], sum[:16])
+			setVer4Var1(&b)
+			b[0] = byte(s + numShards*(int(b[0])%choices))
+			out = append(out, encode(b))
+		}
+	}
+	return out, nil
+}
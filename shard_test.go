@@ -0,0 +1,139 @@
+package uuid
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestNibblePartition(t *testing.T) {
+	const hexDigits = "0123456789abcdef"
+	for i := range 16 {
+		u := UUID(hexDigits[i:i+1] + string(testUUID[1:]))
+		got, err := u.NibblePartition()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != i {
+			t.Errorf("%s.NibblePartition() = %d, want %d", u, got, i)
+		}
+	}
+	if _, err := UUID("not-a-uuid").NibblePartition(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestPartitionName(t *testing.T) {
+	var names [16]string
+	for i := range names {
+		names[i] = "shard-" + strconv.Itoa(i)
+	}
+	got, err := UUID("c" + string(testUUID[1:])).PartitionName(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "shard-12" {
+		t.Errorf("PartitionName() = %q, want %q", got, "shard-12")
+	}
+}
+
+func TestReplicasStableWhenAddingNode(t *testing.T) {
+	const nodes, rf = 10, 3
+	moved := 0
+	for _, u := range randomUUIDs(t, 500) {
+		before, err := u.Replicas(nodes, rf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, _ := u.Replicas(nodes, rf)
+		if !slices.Equal(before, again) {
+			t.Fatalf("Replicas() not deterministic for %s", u)
+		}
+		if len(before) != rf || len(distinct(before)) != rf {
+			t.Fatalf("Replicas() = %v, want %d distinct nodes", before, rf)
+		}
+		after, err := u.Replicas(nodes+1, rf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Contains(after, nodes) {
+			if !slices.Equal(after, before) {
+				t.Errorf("%s: placement changed from %v to %v without the new node", u, before, after)
+			}
+			continue
+		}
+		moved++
+		for _, n := range after {
+			if n != nodes && !slices.Contains(before, n) {
+				t.Errorf("%s: node %d gained a replica when node %d was added", u, n, nodes)
+			}
+		}
+	}
+	// The new node should win about rf/(nodes+1) of the placements.
+	if moved < 75 || moved > 200 {
+		t.Errorf("new node took %d of 500 placements, want about 136", moved)
+	}
+}
+
+func TestReplicasErrors(t *testing.T) {
+	for _, p := range [][2]int{{0, 1}, {3, 0}, {3, 4}} {
+		if _, err := testUUID.Replicas(p[0], p[1]); err == nil {
+			t.Errorf("Replicas(%d, %d): expected error", p[0], p[1])
+		}
+	}
+	if _, err := UUID("not-a-uuid").Replicas(3, 1); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+// distinct returns the sorted distinct elements of s.
+func distinct(s []int) []int {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return slices.Compact(s)
+}
+
+func TestBalancedSetPerShardCount(t *testing.T) {
+	for _, p := range [][2]int{{1, 5}, {3, 4}, {7, 10}, {16, 3}, {256, 2}} {
+		numShards, perShard := p[0], p[1]
+		us, err := BalancedSet(numShards, perShard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(us) != numShards*perShard {
+			t.Fatalf("BalancedSet(%d, %d) has %d UUIDs", numShards, perShard, len(us))
+		}
+		counts := make([]int, numShards)
+		for i, u := range us {
+			if !IsValid(string(u)) {
+				t.Fatalf("BalancedSet(%d, %d)[%d] = %s, want a valid v4 UUID", numShards, perShard, i, u)
+			}
+			b, _ := decode(u)
+			shard := int(b[0]) % numShards
+			if want := i / perShard; shard != want {
+				t.Errorf("BalancedSet(%d, %d)[%d] in shard %d, want %d", numShards, perShard, i, shard, want)
+			}
+			counts[shard]++
+		}
+		for s, c := range counts {
+			if c != perShard {
+				t.Errorf("BalancedSet(%d, %d): shard %d has %d UUIDs", numShards, perShard, s, c)
+			}
+		}
+		if len(Dedup(us)) != len(us) {
+			t.Errorf("BalancedSet(%d, %d) contains duplicates", numShards, perShard)
+		}
+		again, _ := BalancedSet(numShards, perShard)
+		if !slices.Equal(again, us) {
+			t.Errorf("BalancedSet(%d, %d) not deterministic", numShards, perShard)
+		}
+	}
+}
+
+func TestBalancedSetErrors(t *testing.T) {
+	for _, p := range [][2]int{{0, 1}, {257, 1}, {4, 0}, {4, -1}} {
+		if _, err := BalancedSet(p[0], p[1]); err == nil {
+			t.Errorf("BalancedSet(%d, %d): expected error", p[0], p[1])
+		}
+	}
+}
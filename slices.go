@@ -0,0 +1,90 @@
+package uuid
+
+import "fmt"
+
+// AllSameVersion reports whether every element of us is a valid UUID and
+// all share the same version.
+//
+// Parameters:
+//   - us: The UUIDs to check.
+//
+// Returns:
+//   - int: The common version, or 0 if there is none.
+//   - bool: True if us is non-empty, valid and homogeneous. An empty slice
+//     yields (0, false).
+func AllSameVersion(us []UUID) (int, bool) {
+	version := -1
+	for _, u := range us {
+		b, err := decode(u)
+		if err != nil {
+			return 0, false
+		}
+		v := int(b[6] >> 4)
+		if version >= 0 && v != version {
+			return 0, false
+		}
+		version = v
+	}
+	if version < 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// Chunk splits us into numChunks contiguous chunks for parallel processing.
+// Each chunk holds len(us)/numChunks elements, except the last, which also
+// absorbs the remainder. If us has fewer elements than numChunks, each
+// element gets a chunk of its own and fewer chunks are returned, so no
+// chunk is ever empty. Chunks are views of us without copying, capped at
+// their length so that appending to one never overwrites the next.
+//
+// Parameters:
+//   - us: The UUIDs to split.
+//   - numChunks: The number of chunks. Must be positive.
+//
+// Returns:
+//   - [][]UUID: The chunks, in order, min(numChunks, len(us)) of them.
+//   - error: An error if numChunks is not positive.
+func Chunk(us []UUID, numChunks int) ([][]UUID, error) {
+	if numChunks <= 0 {
+		return nil, fmt.Errorf("Chunk: chunk count must be positive: %d", numChunks)
+	}
+	numChunks = min(numChunks, len(us))
+	chunks := make([][]UUID, numChunks)
+	if numChunks == 0 {
+		return chunks, nil
+	}
+	size := len(us) / numChunks
+	for i := range chunks {
+		lo, hi := i*size, (i+1)*size
+		if i == numChunks-1 {
+			hi = len(us)
+		}
+		chunks[i] = us[lo:hi:hi]
+	}
+	return chunks, nil
+}
+
+// Map applies fn to each element of us, such as a normalization,
+// obfuscation or derivation, and returns the results in input order. It
+// stops at the first error, which is returned wrapped with the element's
+// index.
+//
+// Parameters:
+//   - us: The UUIDs to transform.
+//   - fn: The transformation.
+//
+// Returns:
+//   - []UUID: The transformed UUIDs, empty for empty input.
+//   - error: An error naming the index of the first element fn failed on.
+func Map(us []UUID, fn func(UUID) (UUID, error)) ([]UUID, error) {
+	out := make([]UUID, len(us))
+	for i, u := range us {
+		v, err := fn(u)
+		if err != nil {
+			return nil, fmt.Errorf("Map: element %d: %w", i, err)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
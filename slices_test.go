@@ -0,0 +1,136 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAllSameVersion(t *testing.T) {
+	now := time.Now()
+	v7s := []UUID{v7At(t, now), v7At(t, now.Add(time.Second)), v7At(t, now)}
+	tests := []struct {
+		name    string
+		us      []UUID
+		version int
+		ok      bool
+	}{
+		{"uniform v4", randomUUIDs(t, 5), 4, true},
+		{"uniform v7", v7s, 7, true},
+		{"single", []UUID{testUUID}, 4, true},
+		{"mixed", append([]UUID{testUUID}, v7s...), 0, false},
+		{"invalid", []UUID{testUUID, "not-a-uuid"}, 0, false},
+		{"empty", nil, 0, false},
+	}
+	for _, tt := range tests {
+		version, ok := AllSameVersion(tt.us)
+		if version != tt.version || ok != tt.ok {
+			t.Errorf("%s: AllSameVersion() = (%d, %v), want (%d, %v)",
+				tt.name, version, ok, tt.version, tt.ok)
+		}
+	}
+}
+
+func TestChunk(t *testing.T) {
+	us := randomUUIDs(t, 10)
+	tests := []struct {
+		n     int
+		sizes []int
+	}{
+		{1, []int{10}},
+		{2, []int{5, 5}},
+		{5, []int{2, 2, 2, 2, 2}},
+		{3, []int{3, 3, 4}},
+		{4, []int{2, 2, 2, 4}},
+		{10, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{25, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+	}
+	for _, tt := range tests {
+		chunks, err := Chunk(us, tt.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(chunks) != len(tt.sizes) {
+			t.Fatalf("Chunk(%d) returned %d chunks, want %d", tt.n, len(chunks), len(tt.sizes))
+		}
+		next := 0
+		for i, c := range chunks {
+			if len(c) != tt.sizes[i] {
+				t.Errorf("Chunk(%d)[%d] has %d elements, want %d", tt.n, i, len(c), tt.sizes[i])
+			}
+			if &c[0] != &us[next] {
+				t.Errorf("Chunk(%d)[%d] is not a view of the input", tt.n, i)
+			}
+			next += len(c)
+		}
+	}
+}
+
+func TestChunkAppendDoesNotOverwrite(t *testing.T) {
+	us := randomUUIDs(t, 4)
+	second := us[2]
+	chunks, err := Chunk(us, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = append(chunks[0], testUUID)
+	if chunks[1][0] != second {
+		t.Error("appending to a chunk overwrote the next chunk")
+	}
+}
+
+func TestChunkEdgeCases(t *testing.T) {
+	if chunks, err := Chunk(nil, 3); err != nil || len(chunks) != 0 {
+		t.Errorf("Chunk(nil, 3) = %v, %v; want no chunks", chunks, err)
+	}
+	for _, n := range []int{0, -1} {
+		if _, err := Chunk(randomUUIDs(t, 2), n); err == nil {
+			t.Errorf("Chunk(%d): expected error", n)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	us := randomUUIDs(t, 5)
+	upper := func(u UUID) (UUID, error) { return UUID(strings.ToUpper(string(u))), nil }
+	got, err := Map(us, upper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(us) {
+		t.Fatalf("Map() returned %d elements, want %d", len(got), len(us))
+	}
+	for i, u := range got {
+		if want := strings.ToUpper(string(us[i])); string(u) != want {
+			t.Errorf("Map()[%d] = %s, want %s", i, u, want)
+		}
+	}
+
+	errBoom := errors.New("boom")
+	calls := 0
+	failing := func(u UUID) (UUID, error) {
+		calls++
+		if u == us[2] {
+			return "", errBoom
+		}
+		return u, nil
+	}
+	got, err = Map(us, failing)
+	if !errors.Is(err, errBoom) || got != nil {
+		t.Fatalf("Map() = %v, %v; want nil, errBoom", got, err)
+	}
+	if !strings.Contains(err.Error(), "element 2") {
+		t.Errorf("error %q does not name element 2", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+
+	for _, in := range [][]UUID{nil, {}} {
+		got, err := Map(in, failing)
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Map(%#v) = %#v, %v; want empty slice", in, got, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package uuid
+
+import "fmt"
+
+// Snowflake bit layout of the high 64 bits: 1 unused sign bit, 41-bit
+// timestamp, 10-bit worker ID and 12-bit sequence.
+const (
+	snowflakeTimestampBits = 41
+	snowflakeWorkerBits    = 10
+	snowflakeSequenceBits  = 12
+)
+
+// AsSnowflake reinterprets the high 64 bits of the UUID using the Twitter
+// Snowflake layout: the sign bit is ignored, followed by a 41-bit
+// timestamp, a 10-bit worker ID and a 12-bit sequence. The timestamp is in
+// the epoch and unit of the originating system (milliseconds since the
+// Twitter epoch in the original scheme). This is a best-effort
+// reinterpretation for migration analytics: UUIDs not built with
+// FromSnowflake yield arbitrary, though well-formed, values.
+//
+// Returns:
+//   - int64: The timestamp field.
+//   - int64: The worker ID field.
+//   - int64: The sequence field.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) AsSnowflake() (timestamp int64, workerID int64, sequence int64, err error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("AsSnowflake: %w", err)
+	}
+	hi, _ := toUint128(b)
+	sequence = int64(hi & (1<<snowflakeSequenceBits - 1))
+	hi >>= snowflakeSequenceBits
+	workerID = int64(hi & (1<<snowflakeWorkerBits - 1))
+	hi >>= snowflakeWorkerBits
+	timestamp = int64(hi & (1<<snowflakeTimestampBits - 1))
+	return timestamp, workerID, sequence, nil
+}
+
+// FromSnowflake builds a UUID whose high 64 bits hold a Snowflake ID with
+// the given fields, as read back by AsSnowflake. The low 64 bits are zero
+// and no version or variant bits are set.
+//
+// Parameters:
+//   - timestamp: The 41-bit timestamp field.
+//   - workerID: The 10-bit worker ID field.
+//   - sequence: The 12-bit sequence field.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if any field is negative or exceeds its width.
+func FromSnowflake(timestamp, workerID, sequence int64) (UUID, error) {
+	if timestamp < 0 || timestamp >= 1<<snowflakeTimestampBits {
+		return "", fmt.Errorf("FromSnowflake: timestamp out of range: %d", timestamp)
+	}
+	if workerID < 0 || workerID >= 1<<snowflakeWorkerBits {
+		return "", fmt.Errorf("FromSnowflake: worker ID out of range: %d", workerID)
+	}
+	if sequence < 0 || sequence >= 1<<snowflakeSequenceBits {
+		return "", fmt.Errorf("FromSnowflake: sequence out of range: %d", sequence)
+	}
+	hi := uint64(timestamp)<<(snowflakeWorkerBits+snowflakeSequenceBits) |
+		uint64(workerID)<<snowflakeSequenceBits |
+		uint64(sequence)
+	return encode(fromUint128(hi, 0)), nil
+}
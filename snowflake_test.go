@@ -0,0 +1,53 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestSnowflakeRoundTrip(t *testing.T) {
+	// 1541815603606036480 is 0x1565a11f6217a000.
+	const timestamp, workerID, sequence = 367597485448, 378, 0
+	u, err := FromSnowflake(timestamp, workerID, sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("1565a11f-6217-a000-0000-000000000000"); u != want {
+		t.Errorf("FromSnowflake() = %s, want %s", u, want)
+	}
+	tests := [][3]int64{
+		{timestamp, workerID, sequence},
+		{0, 0, 0},
+		{1, 2, 3},
+		{1<<41 - 1, 1<<10 - 1, 1<<12 - 1},
+	}
+	for _, tt := range tests {
+		u, err := FromSnowflake(tt[0], tt[1], tt[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		ts, w, s, err := u.AsSnowflake()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ts != tt[0] || w != tt[1] || s != tt[2] {
+			t.Errorf("%s.AsSnowflake() = %d, %d, %d; want %d, %d, %d", u, ts, w, s, tt[0], tt[1], tt[2])
+		}
+	}
+}
+
+func TestFromSnowflakeErrors(t *testing.T) {
+	for _, tt := range [][3]int64{
+		{-1, 0, 0},
+		{1 << 41, 0, 0},
+		{0, 1 << 10, 0},
+		{0, 0, 1 << 12},
+		{0, 0, -1},
+	} {
+		if _, err := FromSnowflake(tt[0], tt[1], tt[2]); err == nil {
+			t.Errorf("FromSnowflake(%d, %d, %d): expected error", tt[0], tt[1], tt[2])
+		}
+	}
+	if _, _, _, err := UUID("not-a-uuid").AsSnowflake(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
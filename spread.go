@@ -0,0 +1,94 @@
+package uuid
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SpreadGenerator issues random Version 4, Variant 1 UUIDs that stay far
+// apart in the XOR metric from the most recently issued ones, spreading
+// small correlated batches across the keyspace. Candidates are drawn with
+// Ver4Where and rejected while they share prefixBits or more leading bits
+// with any of the last window issued UUIDs; equivalently, the XOR of an
+// accepted UUID with each of them, read as a 128-bit integer, is at least
+// 2^(128-prefixBits). A single generator may be shared by multiple
+// goroutines.
+//
+// Each candidate is rejected with probability at most window/2^prefixBits,
+// so prefixBits should comfortably exceed log2(window); e.g. a window of 16
+// with 8 prefix bits rejects at most 1 candidate in 16.
+type SpreadGenerator struct {
+	prefixBits  int
+	maxAttempts int
+	mu          sync.Mutex
+	recent      [][16]byte
+	next        int
+}
+
+// NewSpreadGenerator returns a SpreadGenerator remembering the last window
+// issued UUIDs.
+//
+// Parameters:
+//   - window: The number of recent UUIDs to keep apart from. Must be
+//     positive.
+//   - prefixBits: The number of leading bits in which a new UUID must differ
+//     from each recent one, in [1, 48], the random bits preceding the
+//     version.
+//   - maxAttempts: The maximum number of candidates per UUID. Must be
+//     positive.
+//
+// Returns:
+//   - *SpreadGenerator: The generator.
+//   - error: An error if any argument is out of range.
+func NewSpreadGenerator(window, prefixBits, maxAttempts int) (*SpreadGenerator, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("NewSpreadGenerator: window must be positive: %d", window)
+	}
+	if prefixBits < 1 || prefixBits > 48 {
+		return nil, fmt.Errorf(
+			"NewSpreadGenerator: prefix bits must be in [1, 48]: %d", prefixBits,
+		)
+	}
+	if maxAttempts <= 0 {
+		return nil, fmt.Errorf(
+			"NewSpreadGenerator: max attempts must be positive: %d", maxAttempts,
+		)
+	}
+	return &SpreadGenerator{
+		prefixBits:  prefixBits,
+		maxAttempts: maxAttempts,
+		recent:      make([][16]byte, 0, window),
+	}, nil
+}
+
+// Next issues a UUID kept apart from the recent window and adds it to the
+// window, evicting the oldest entry once the window is full.
+//
+// Returns:
+//   - UUID: The issued UUID.
+//   - error: An error wrapping ErrPredicateUnsatisfied if no candidate was
+//     accepted within the attempt limit, or an error if crypto/rand fails.
+func (g *SpreadGenerator) Next() (UUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var b [16]byte
+	u, err := Ver4Where(func(c UUID) bool {
+		b, _ = decode(c)
+		for _, r := range g.recent {
+			if commonPrefixLen(b, r) >= g.prefixBits {
+				return false
+			}
+		}
+		return true
+	}, g.maxAttempts)
+	if err != nil {
+		return "", fmt.Errorf("SpreadGenerator.Next: %w", err)
+	}
+	if len(g.recent) < cap(g.recent) {
+		g.recent = append(g.recent, b)
+	} else {
+		g.recent[g.next] = b
+		g.next = (g.next + 1) % len(g.recent)
+	}
+	return u, nil
+}
@@ -0,0 +1,64 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSpreadGeneratorMinDistance(t *testing.T) {
+	const window, prefixBits = 16, 8
+	g, err := NewSpreadGenerator(window, prefixBits, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var issued [][16]byte
+	for range 200 {
+		u, err := g.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsValid(string(u)) || string(u) != strings.ToLower(string(u)) {
+			t.Fatalf("Next() = %s, want a lowercase v4 UUID", u)
+		}
+		b, _ := decode(u)
+		issued = append(issued, b)
+	}
+	for i := range issued {
+		for j := max(0, i-window); j < i; j++ {
+			// With 8 prefix bits the XOR must be at least 2^120, i.e. the
+			// first bytes must differ.
+			if issued[i][0] == issued[j][0] {
+				t.Errorf("UUIDs %d and %d are %d apart but share their first byte", j, i, i-j)
+			}
+			if n := commonPrefixLen(issued[i], issued[j]); n >= prefixBits {
+				t.Errorf("UUIDs %d and %d share %d leading bits", j, i, n)
+			}
+		}
+	}
+}
+
+func TestSpreadGeneratorExhausted(t *testing.T) {
+	// Two recent UUIDs that differ in the first bit leave no room for a
+	// third at one prefix bit.
+	g, err := NewSpreadGenerator(2, 1, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, err := g.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := g.Next(); !errors.Is(err, ErrPredicateUnsatisfied) {
+		t.Errorf("Next() error = %v, want ErrPredicateUnsatisfied", err)
+	}
+}
+
+func TestNewSpreadGeneratorErrors(t *testing.T) {
+	for _, args := range [][3]int{{0, 8, 10}, {4, 0, 10}, {4, 49, 10}, {4, 8, 0}} {
+		if _, err := NewSpreadGenerator(args[0], args[1], args[2]); err == nil {
+			t.Errorf("NewSpreadGenerator(%d, %d, %d): expected error", args[0], args[1], args[2])
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package uuid
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// validateChanBuffer is the capacity of each channel returned by
+// ValidateChan.
+const validateChanBuffer = 64
+
+// ReadBinaryStream reads concatenated 16-byte UUIDs from r and calls fn with
+// each one in lowercase canonical form. Records are read one at a time, so
+// arbitrarily large inputs are streamed.
+//
+// Reading stops cleanly at io.EOF on a record boundary. A trailing partial
+// record yields io.ErrUnexpectedEOF. An error returned by fn stops reading
+// and is returned as-is.
+//
+// Parameters:
+//   - r: The reader to consume.
+//   - fn: The function called for each UUID.
+//
+// Returns:
+//   - error: An error from r, io.ErrUnexpectedEOF, or fn's error.
+func ReadBinaryStream(r io.Reader, fn func(UUID) error) error {
+	var b [16]byte
+	for {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := fn(encode(b)); err != nil {
+			return err
+		}
+	}
+}
+
+// ValidateChan validates each string received from in, which may be in
+// canonical, braced, URN or compact form, in a separate goroutine. Valid
+// UUIDs are sent in lowercase canonical form on the first returned channel
+// and failures, naming the offending string, on the second. Both channels
+// are buffered with capacity 64, so a burst of one kind does not stall the
+// other, and both are closed once in is closed and drained. Callers must
+// keep receiving from both channels until they are closed.
+//
+// Parameters:
+//   - in: The strings to validate.
+//
+// Returns:
+//   - <-chan UUID: The valid UUIDs, in input order.
+//   - <-chan error: The validation errors, in input order.
+func ValidateChan(in <-chan string) (<-chan UUID, <-chan error) {
+	out := make(chan UUID, validateChanBuffer)
+	errs := make(chan error, validateChanBuffer)
+	go func() {
+		defer close(out)
+		defer close(errs)
+		for s := range in {
+			b, err := decodeLenient(s)
+			if err != nil {
+				errs <- fmt.Errorf("ValidateChan: %w", err)
+				continue
+			}
+			out <- encode(b)
+		}
+	}()
+	return out, errs
+}
+
+// UUIDResult is a generated UUID or the error that prevented generating it.
+type UUIDResult struct {
+	UUID UUID
+	Err  error
+}
+
+// GenerateToChan generates random Version 4, Variant 1 UUIDs in lowercase
+// canonical form in a separate goroutine and sends them on the returned
+// channel. The channel is unbuffered, so generation proceeds only as fast as
+// the consumer receives. Generation errors are delivered as results rather
+// than stopping the stream. The channel is closed after n results, or as
+// soon as ctx is cancelled.
+//
+// Parameters:
+//   - ctx: The context whose cancellation stops generation.
+//   - n: The number of UUIDs to send. A negative n means no limit.
+//
+// Returns:
+//   - <-chan UUIDResult: The generated UUIDs.
+func GenerateToChan(ctx context.Context, n int) <-chan UUIDResult {
+	out := make(chan UUIDResult)
+	go func() {
+		defer close(out)
+		for i := 0; n < 0 || i < n; i++ {
+			var res UUIDResult
+			if b, err := random16(); err != nil {
+				res.Err = fmt.Errorf("GenerateToChan: %w", err)
+			} else {
+				setVer4Var1(&b)
+				res.UUID = encode(b)
+			}
+			select {
+			case out <- res:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterValid reads newline-delimited UUIDs from r and writes each valid
+// one to w in lowercase canonical form followed by a newline, dropping the
+// rest. Surrounding whitespace, including a trailing carriage return, is
+// trimmed, and canonical, braced, URN and compact forms are accepted in any
+// case. Blank lines are skipped and counted as neither valid nor invalid.
+// Output is buffered and flushed before returning.
+//
+// Parameters:
+//   - r: The input, one UUID per line.
+//   - w: The output for the valid UUIDs.
+//
+// Returns:
+//   - valid: The number of valid lines written.
+//   - invalid: The number of non-blank lines dropped.
+//   - err: An error from reading r, including a line too long to buffer,
+//     or from writing w.
+func FilterValid(r io.Reader, w io.Writer) (valid int, invalid int, err error) {
+	sc := bufio.NewScanner(r)
+	bw := bufio.NewWriter(w)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		b, err := decodeLenient(line)
+		if err != nil {
+			invalid++
+			continue
+		}
+		if _, err := bw.WriteString(string(encode(b)) + "\n"); err != nil {
+			return valid, invalid, fmt.Errorf("FilterValid: %w", err)
+		}
+		valid++
+	}
+	if err := sc.Err(); err != nil {
+		return valid, invalid, fmt.Errorf("FilterValid: %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return valid, invalid, fmt.Errorf("FilterValid: %w", err)
+	}
+	return valid, invalid, nil
+}
@@ -0,0 +1,191 @@
+package uuid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadBinaryStream(t *testing.T) {
+	us := randomUUIDs(t, 3)
+	var buf bytes.Buffer
+	for _, u := range us {
+		b, _ := decode(u)
+		buf.Write(b[:])
+	}
+	var got []UUID
+	err := ReadBinaryStream(&buf, func(u UUID) error {
+		got = append(got, u)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, us) {
+		t.Errorf("got %v, want %v", got, us)
+	}
+}
+
+func TestReadBinaryStreamPartialTrailingRecord(t *testing.T) {
+	data := make([]byte, 16+5)
+	n := 0
+	err := ReadBinaryStream(bytes.NewReader(data), func(UUID) error {
+		n++
+		return nil
+	})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("got error %v, want io.ErrUnexpectedEOF", err)
+	}
+	if n != 1 {
+		t.Errorf("got %d complete records, want 1", n)
+	}
+}
+
+func TestReadBinaryStreamCallbackError(t *testing.T) {
+	stop := errors.New("stop")
+	err := ReadBinaryStream(bytes.NewReader(make([]byte, 32)), func(UUID) error {
+		return stop
+	})
+	if err != stop {
+		t.Errorf("got error %v, want the callback's error", err)
+	}
+}
+
+func TestValidateChan(t *testing.T) {
+	inputs := []string{
+		string(testUUID),
+		"not-a-uuid",
+		"{" + strings.ToUpper(string(testUUID)) + "}",
+		"",
+		strings.ReplaceAll(string(testUUID), "-", ""),
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5cXX",
+	}
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, s := range inputs {
+			in <- s
+		}
+	}()
+	out, errs := ValidateChan(in)
+	var got []UUID
+	var gotErrs []error
+	for out != nil || errs != nil {
+		select {
+		case u, ok := <-out:
+			if !ok {
+				out = nil
+				continue
+			}
+			got = append(got, u)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			gotErrs = append(gotErrs, err)
+		}
+	}
+	if want := []UUID{testUUID, testUUID, testUUID}; !slices.Equal(got, want) {
+		t.Errorf("valid UUIDs = %v, want %v", got, want)
+	}
+	if len(gotErrs) != 3 {
+		t.Fatalf("got %d errors, want 3: %v", len(gotErrs), gotErrs)
+	}
+	if !strings.Contains(gotErrs[0].Error(), "not-a-uuid") {
+		t.Errorf("error %q does not name the offending string", gotErrs[0])
+	}
+}
+
+func TestGenerateToChanBounded(t *testing.T) {
+	var got []UUID
+	for res := range GenerateToChan(t.Context(), 25) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if !IsValid(string(res.UUID)) || string(res.UUID) != strings.ToLower(string(res.UUID)) {
+			t.Fatalf("GenerateToChan sent %s, want a lowercase v4 UUID", res.UUID)
+		}
+		got = append(got, res.UUID)
+	}
+	if len(got) != 25 {
+		t.Errorf("received %d UUIDs, want 25", len(got))
+	}
+	if len(Dedup(got)) != len(got) {
+		t.Error("GenerateToChan sent duplicate UUIDs")
+	}
+}
+
+func TestGenerateToChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	ch := GenerateToChan(ctx, -1)
+	for range 10 {
+		if res := <-ch; res.Err != nil {
+			t.Fatal(res.Err)
+		}
+	}
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range ch {
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed after cancellation")
+	}
+}
+
+func TestFilterValid(t *testing.T) {
+	u := string(testUUID)
+	in := strings.Join([]string{
+		u,
+		"",
+		"  " + strings.ToUpper(u) + "\r",
+		"not-a-uuid",
+		"{" + u + "}",
+		"   ",
+		"urn:uuid:" + u,
+		strings.ReplaceAll(u, "-", ""),
+		u + "x",
+	}, "\n")
+	var out strings.Builder
+	valid, invalid, err := FilterValid(strings.NewReader(in), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid != 5 || invalid != 2 {
+		t.Errorf("FilterValid() counts = %d valid, %d invalid; want 5, 2", valid, invalid)
+	}
+	if want := strings.Repeat(u+"\n", 5); out.String() != want {
+		t.Errorf("FilterValid() wrote %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	valid, invalid, err = FilterValid(strings.NewReader(""), &out)
+	if err != nil || valid != 0 || invalid != 0 || out.Len() != 0 {
+		t.Errorf("empty input: %d, %d, %v, %q", valid, invalid, err, out.String())
+	}
+}
+
+// failingWriter rejects every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestFilterValidErrors(t *testing.T) {
+	if _, _, err := FilterValid(strings.NewReader(string(testUUID)), failingWriter{}); err == nil {
+		t.Error("failing writer: expected error")
+	}
+	long := strings.Repeat("a", 1<<17)
+	if _, _, err := FilterValid(strings.NewReader(long), &strings.Builder{}); err == nil {
+		t.Error("overlong line: expected error")
+	}
+}
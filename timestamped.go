@@ -0,0 +1,146 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrTimestampedSeparator is returned when a compound Timestamped value
+// lacks the "@" separator.
+var ErrTimestampedSeparator = errors.New("missing separator in timestamped UUID")
+
+// ErrTimestampedUUID is returned when the UUID part of a compound
+// Timestamped value is malformed.
+var ErrTimestampedUUID = errors.New("invalid UUID in timestamped UUID")
+
+// ErrTimestampedTime is returned when the time part of a compound
+// Timestamped value is malformed.
+var ErrTimestampedTime = errors.New("invalid time in timestamped UUID")
+
+// Timestamped bundles a UUID with a creation time stored alongside it, such
+// as for Version 4 UUIDs, which embed no time of their own. It serializes
+// as the single compound value "<uuid>@<time>", with the UUID in lowercase
+// canonical form and the time in RFC 3339 with nanoseconds, e.g.
+// "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d@2024-06-15T10:00:00.5Z". It
+// implements encoding.TextMarshaler and encoding.TextUnmarshaler, which also
+// makes it a JSON string, and driver.Valuer and sql.Scanner.
+type Timestamped struct {
+	UUID UUID
+	Time time.Time
+}
+
+// String returns the compound value. An invalid UUID is written as-is and
+// will be rejected by ParseTimestamped.
+//
+// Returns:
+//   - string: The compound value.
+func (t Timestamped) String() string {
+	s := string(t.UUID)
+	if b, err := decode(t.UUID); err == nil {
+		s = string(encode(b))
+	}
+	return s + "@" + t.Time.Format(time.RFC3339Nano)
+}
+
+// ParseTimestamped parses a compound value produced by Timestamped.String.
+// The time keeps its UTC offset.
+//
+// Parameters:
+//   - s: The compound value.
+//
+// Returns:
+//   - Timestamped: The parsed value, with the UUID in lowercase canonical
+//     form.
+//   - error: An error wrapping ErrTimestampedSeparator, ErrTimestampedUUID
+//     or ErrTimestampedTime describing what is malformed.
+func ParseTimestamped(s string) (Timestamped, error) {
+	head, tail, ok := strings.Cut(s, "@")
+	if !ok {
+		return Timestamped{}, fmt.Errorf(
+			"ParseTimestamped: %w: %s", ErrTimestampedSeparator, s,
+		)
+	}
+	b, err := decode(UUID(head))
+	if err != nil {
+		return Timestamped{}, fmt.Errorf(
+			"ParseTimestamped: %w: %w", ErrTimestampedUUID, err,
+		)
+	}
+	ts, err := time.Parse(time.RFC3339Nano, tail)
+	if err != nil {
+		return Timestamped{}, fmt.Errorf(
+			"ParseTimestamped: %w: %w", ErrTimestampedTime, err,
+		)
+	}
+	return Timestamped{UUID: encode(b), Time: ts}, nil
+}
+
+// MarshalText encodes the compound value.
+//
+// Returns:
+//   - []byte: The compound value.
+//   - error: An error if the UUID is not valid.
+func (t Timestamped) MarshalText() ([]byte, error) {
+	if _, err := decode(t.UUID); err != nil {
+		return nil, fmt.Errorf("Timestamped.MarshalText: %w", err)
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes a compound value with ParseTimestamped.
+//
+// Parameters:
+//   - text: The compound value.
+//
+// Returns:
+//   - error: An error if text is malformed.
+func (t *Timestamped) UnmarshalText(text []byte) error {
+	v, err := ParseTimestamped(string(text))
+	if err != nil {
+		return fmt.Errorf("Timestamped.UnmarshalText: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// Value stores the compound value as a string column.
+//
+// Returns:
+//   - driver.Value: The compound value as a string.
+//   - error: An error if the UUID is not valid.
+func (t Timestamped) Value() (driver.Value, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, fmt.Errorf("Timestamped.Value: %w", err)
+	}
+	return string(text), nil
+}
+
+// Scan reads a compound value from a string or []byte column. NULL is
+// rejected, since there is no null Timestamped.
+//
+// Parameters:
+//   - src: The column value.
+//
+// Returns:
+//   - error: An error if src is not a string or []byte or is malformed.
+func (t *Timestamped) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("Timestamped.Scan: unsupported type %T", src)
+	}
+	v, err := ParseTimestamped(s)
+	if err != nil {
+		return fmt.Errorf("Timestamped.Scan: %w", err)
+	}
+	*t = v
+	return nil
+}
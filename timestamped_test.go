@@ -0,0 +1,85 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimestampedRoundTrip(t *testing.T) {
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	want := Timestamped{
+		UUID: "6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6D",
+		Time: time.Date(2024, 6, 15, 10, 0, 0, 500, zone),
+	}
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := `"` + string(testUUID) + `@2024-06-15T10:00:00.0000005+02:00"`; string(data) != s {
+		t.Errorf("json.Marshal() = %s, want %s", data, s)
+	}
+	var viaJSON Timestamped
+	if err := json.Unmarshal(data, &viaJSON); err != nil {
+		t.Fatal(err)
+	}
+	if viaJSON.UUID != testUUID || !viaJSON.Time.Equal(want.Time) {
+		t.Errorf("JSON round trip = %+v, want %+v", viaJSON, want)
+	}
+	if _, off := viaJSON.Time.Zone(); off != 2*60*60 {
+		t.Errorf("JSON round trip lost the UTC offset: %s", viaJSON.Time)
+	}
+
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, src := range []any{v, []byte(v.(string))} {
+		var viaSQL Timestamped
+		if err := viaSQL.Scan(src); err != nil {
+			t.Fatalf("Scan(%T): %v", src, err)
+		}
+		if viaSQL != viaJSON {
+			t.Errorf("Scan(%T) = %+v, want %+v", src, viaSQL, viaJSON)
+		}
+	}
+}
+
+func TestTimestampedMalformed(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{string(testUUID), ErrTimestampedSeparator},
+		{"not-a-uuid@2024-06-15T10:00:00Z", ErrTimestampedUUID},
+		{string(testUUID) + "@yesterday", ErrTimestampedTime},
+		{string(testUUID) + "@", ErrTimestampedTime},
+	}
+	for _, tt := range tests {
+		if _, err := ParseTimestamped(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("ParseTimestamped(%q) error = %v, want %v", tt.in, err, tt.want)
+		}
+		var ts Timestamped
+		if err := ts.Scan(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("Scan(%q) error = %v, want %v", tt.in, err, tt.want)
+		}
+		if err := json.Unmarshal([]byte(`"`+tt.in+`"`), &ts); !errors.Is(err, tt.want) {
+			t.Errorf("json.Unmarshal(%q) error = %v, want %v", tt.in, err, tt.want)
+		}
+	}
+	var ts Timestamped
+	for _, src := range []any{nil, 42} {
+		if err := ts.Scan(src); err == nil {
+			t.Errorf("Scan(%#v): expected error", src)
+		}
+	}
+	bad := Timestamped{UUID: "not-a-uuid", Time: time.Now()}
+	if _, err := json.Marshal(bad); err == nil {
+		t.Error("json.Marshal with invalid UUID: expected error")
+	}
+	if _, err := bad.Value(); err == nil {
+		t.Error("Value with invalid UUID: expected error")
+	}
+}
@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// TraceAndSpan generates a fresh trace ID and a first span ID under it. The
+// trace ID is a random Version 4, Variant 1 UUID; the span ID is minted with
+// NewSpan.
+//
+// Returns:
+//   - UUID: The trace ID.
+//   - UUID: The span ID.
+//   - error: An error if crypto/rand fails.
+func TraceAndSpan() (traceID UUID, spanID UUID, err error) {
+	b, err := random16()
+	if err != nil {
+		return "", "", fmt.Errorf("TraceAndSpan: %w", err)
+	}
+	setVer4Var1(&b)
+	traceID = encode(b)
+	spanID, err = NewSpan(traceID)
+	if err != nil {
+		return "", "", fmt.Errorf("TraceAndSpan: %w", err)
+	}
+	return traceID, spanID, nil
+}
+
+// NewSpan mints a span ID under traceID. The first 8 bytes of the span are
+// the first 8 bytes of SHA-256 over the trace ID's 16 bytes, so every span
+// of a trace shares them; the last 8 bytes are random, so spans are
+// distinct. The span is shaped as a Version 4, Variant 1 UUID, leaving 60
+// bits of trace tag and 62 random bits. IsSpanOf checks the relationship.
+//
+// Parameters:
+//   - traceID: The trace to mint a span under.
+//
+// Returns:
+//   - UUID: The span ID.
+//   - error: An error if traceID is not a valid UUID or crypto/rand fails.
+func NewSpan(traceID UUID) (UUID, error) {
+	t, err := decode(traceID)
+	if err != nil {
+		return "", fmt.Errorf("NewSpan: %w", err)
+	}
+	b, err := random16()
+	if err != nil {
+		return "", fmt.Errorf("NewSpan: %w", err)
+	}
+	tag := sha256.Sum256(t[:])
+	copy(b[:8], tag[:8])
+	setVer4Var1(&b)
+	return encode(b), nil
+}
+
+// IsSpanOf reports whether the UUID is a span ID minted by NewSpan under
+// traceID, by comparing its trace tag.
+//
+// Parameters:
+//   - traceID: The claimed trace.
+//
+// Returns:
+//   - bool: True if the span belongs to traceID.
+//   - error: An error if the receiver or traceID is not a valid UUID.
+func (u UUID) IsSpanOf(traceID UUID) (bool, error) {
+	s, err := decode(u)
+	if err != nil {
+		return false, fmt.Errorf("IsSpanOf: %w", err)
+	}
+	t, err := decode(traceID)
+	if err != nil {
+		return false, fmt.Errorf("IsSpanOf: trace: %w", err)
+	}
+	var tag [16]byte
+	sum := sha256.Sum256(t[:])
+	copy(tag[:8], sum[:8])
+	setVer4Var1(&tag)
+	return samePrefix(s, tag, 64), nil
+}
@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTraceAndSpan(t *testing.T) {
+	traceID, spanID, err := TraceAndSpan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(traceID) != strings.ToLower(string(traceID)) {
+		t.Errorf("trace ID %s is not lowercase canonical", traceID)
+	}
+	for _, u := range []UUID{traceID, spanID} {
+		if !IsValid(string(u)) {
+			t.Errorf("%s is not a valid v4 UUID", u)
+		}
+	}
+	seen := map[UUID]bool{traceID: true, spanID: true}
+	spans := []UUID{spanID}
+	for range 10 {
+		s, err := NewSpan(traceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[s] {
+			t.Fatalf("NewSpan(%s) repeated %s", traceID, s)
+		}
+		seen[s] = true
+		spans = append(spans, s)
+	}
+	for _, s := range spans {
+		ok, err := s.IsSpanOf(traceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Errorf("%s.IsSpanOf(%s) = false, want true", s, traceID)
+		}
+	}
+	otherTrace, _, err := TraceAndSpan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := spanID.IsSpanOf(otherTrace); ok {
+		t.Errorf("%s.IsSpanOf(%s) = true for an unrelated trace", spanID, otherTrace)
+	}
+}
+
+func TestNewSpanErrors(t *testing.T) {
+	if _, err := NewSpan("not-a-uuid"); err == nil {
+		t.Error("invalid trace ID: expected error")
+	}
+	if _, err := testUUID.IsSpanOf("not-a-uuid"); err == nil {
+		t.Error("invalid trace ID: expected error")
+	}
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrTTLKeyUUID is returned by ParseTTLKey when the UUID part of a key is
+// malformed.
+var ErrTTLKeyUUID = errors.New("invalid UUID in TTL key")
+
+// ErrTTLKeyExpiry is returned by ParseTTLKey when the expiry part of a key
+// is malformed.
+var ErrTTLKeyExpiry = errors.New("invalid expiry in TTL key")
+
+// TTLKey returns a cache key combining the UUID with an expiry time, in the
+// form "<uuid>:<unix seconds>", e.g.
+// "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d:1718400000". The UUID is written in
+// lowercase canonical form; an invalid receiver is written as-is and will be
+// rejected by ParseTTLKey. Sub-second precision is dropped.
+//
+// Parameters:
+//   - expiry: The expiry time.
+//
+// Returns:
+//   - string: The TTL key.
+func (u UUID) TTLKey(expiry time.Time) string {
+	s := string(u)
+	if b, err := decode(u); err == nil {
+		s = string(encode(b))
+	}
+	return s + ":" + strconv.FormatInt(expiry.Unix(), 10)
+}
+
+// ParseTTLKey splits a key produced by TTLKey into its UUID and expiry.
+//
+// Parameters:
+//   - s: The TTL key.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - time.Time: The expiry time.
+//   - error: An error wrapping ErrTTLKeyUUID or ErrTTLKeyExpiry if the
+//     respective part is malformed.
+func ParseTTLKey(s string) (UUID, time.Time, error) {
+	head, tail, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", time.Time{}, fmt.Errorf(
+			"ParseTTLKey: %w: missing separator: %s", ErrTTLKeyExpiry, s,
+		)
+	}
+	b, err := decode(UUID(head))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf(
+			"ParseTTLKey: %w: %w", ErrTTLKeyUUID, err,
+		)
+	}
+	secs, err := strconv.ParseInt(tail, 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf(
+			"ParseTTLKey: %w: %s", ErrTTLKeyExpiry, tail,
+		)
+	}
+	return encode(b), time.Unix(secs, 0), nil
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTTLKeyRoundTrip(t *testing.T) {
+	expiry := time.Unix(1718400000, 0)
+	key := UUID(strings.ToUpper(string(testUUID))).TTLKey(expiry)
+	if want := string(testUUID) + ":1718400000"; key != want {
+		t.Errorf("TTLKey() = %q, want %q", key, want)
+	}
+	u, got, err := ParseTTLKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != testUUID || !got.Equal(expiry) {
+		t.Errorf("ParseTTLKey(%q) = %s, %v; want %s, %v", key, u, got, testUUID, expiry)
+	}
+	past := time.Unix(-1, 0)
+	if _, got, err := ParseTTLKey(testUUID.TTLKey(past)); err != nil || !got.Equal(past) {
+		t.Errorf("negative expiry: got %v, %v; want %v", got, err, past)
+	}
+}
+
+func TestParseTTLKeyErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{string(testUUID), ErrTTLKeyExpiry},
+		{string(testUUID) + ":", ErrTTLKeyExpiry},
+		{string(testUUID) + ":soon", ErrTTLKeyExpiry},
+		{string(testUUID) + ":1.5", ErrTTLKeyExpiry},
+		{"not-a-uuid:1718400000", ErrTTLKeyUUID},
+		{":1718400000", ErrTTLKeyUUID},
+	}
+	for _, tt := range tests {
+		if _, _, err := ParseTTLKey(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("ParseTTLKey(%q) error = %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps non-ASCII look-alike characters to the ASCII hex digit
+// or hyphen they are commonly mistaken for.
+var confusables = map[rune]rune{
+	// Hyphen and dash look-alikes.
+	'‐': '-', // hyphen
+	'‑': '-', // non-breaking hyphen
+	'‒': '-', // figure dash
+	'–': '-', // en dash
+	'—': '-', // em dash
+	'―': '-', // horizontal bar
+	'−': '-', // minus sign
+	'﹣': '-', // small hyphen-minus
+	// Cyrillic look-alikes.
+	'а': 'a',
+	'с': 'c',
+	'е': 'e',
+	'о': '0',
+	'А': 'A',
+	'В': 'B',
+	'С': 'C',
+	'Е': 'E',
+	'О': '0',
+	// Greek look-alikes.
+	'Α': 'A',
+	'Β': 'B',
+	'Ε': 'E',
+	'Ο': '0',
+	'ο': '0',
+}
+
+// NormalizeUnicode rescues a UUID that was mangled by copy-paste. The input
+// is first normalized to NFKC, which folds compatibility characters such as
+// fullwidth, circled and mathematical digits and letters to ASCII, e.g.
+// U+FF11 '１' and U+2460 '①' to '1'. The following confusables, which NFKC
+// leaves alone, are then mapped:
+//   - Unicode hyphens and dashes (U+2010-U+2015, U+2212, U+FE63) to '-'.
+//   - Cyrillic а, с, е, А, В, С, Е and Greek Α, Β, Ε to the matching Latin
+//     hex letter.
+//   - Cyrillic and Greek O look-alikes to the digit '0'.
+//
+// Leading and trailing whitespace is removed. The result must then be a
+// valid hyphenated UUID; anything else is rejected.
+//
+// Parameters:
+//   - s: The string to normalize.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if the normalized string is not a valid UUID.
+func NormalizeUnicode(s string) (UUID, error) {
+	var sb strings.Builder
+	sb.Grow(36)
+	for _, r := range norm.NFKC.String(strings.TrimSpace(s)) {
+		if r < 0x80 {
+			sb.WriteRune(r)
+			continue
+		}
+		m, ok := confusables[r]
+		if !ok {
+			return "", fmt.Errorf(
+				"NormalizeUnicode: unsupported character %q in: %s", r, s,
+			)
+		}
+		sb.WriteRune(m)
+	}
+	b, err := decode(UUID(sb.String()))
+	if err != nil {
+		return "", fmt.Errorf("NormalizeUnicode: %w", err)
+	}
+	return encode(b), nil
+}
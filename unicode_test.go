@@ -0,0 +1,49 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestNormalizeUnicode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"ascii", "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d"},
+		{"uppercase", "6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6D"},
+		{"fullwidth digits", "６ｆ１ａ０ｂ１ｃ－８ｄ７ｅ－４ａ２ｂ－８ｃ９ｄ－１ｅ２ｆ３ａ４ｂ５ｃ６ｄ"},
+		{"fullwidth uppercase", "６Ｆ１Ａ0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d"},
+		{"circled and mathematical digits", "⑥f①a0b1c-8d7e-4a2b-8c9d-𝟏e2f3a4b5c6d"},
+		{"dashes", "6f1a0b1c–8d7e—4a2b−8c9d‐1e2f3a4b5c6d"},
+		{"cyrillic letters", "6f1а0b1с-8d7е-4a2b-8c9d-1e2f3a4b5c6d"},
+		{"surrounding space", "  6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d "},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeUnicode(tt.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got != testUUID {
+			t.Errorf("%s: got %s, want %s", tt.name, got, testUUID)
+		}
+	}
+}
+
+func TestNormalizeUnicodeZeroLookAlikes(t *testing.T) {
+	got, err := NormalizeUnicode("ОоΟο0000-0000-4000-8000-000000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("00000000-0000-4000-8000-000000000000"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNormalizeUnicodeInvalid(t *testing.T) {
+	for _, in := range []string{"", "６ｆ１ａ", "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6é"} {
+		if _, err := NormalizeUnicode(in); err == nil {
+			t.Errorf("NormalizeUnicode(%q): expected error", in)
+		}
+	}
+}
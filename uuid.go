@@ -11,8 +11,8 @@ import (
 
 // variant1Chars defines the allowed characters for the variant
 // nibble (variant 1). The high bits are 10xx so the possible hex digits
-// are 8, 9, A, or B.
-const variant1Chars = "89AB"
+// are 8, 9, a, or b.
+const variant1Chars = "89ab"
 
 // uuidV4Regex validates a UUID formatted as 8-4-4-4-12 hex digits,
 // with version "4" and a valid variant (one of 8, 9, A, or B).
@@ -37,9 +37,11 @@ func (u UUID) String() string {
 // Ver4Var1 generates a random UUID. It conforms to Version 4 (random-based) and
 // Variant 1 (RFC 4122). The UUID follows the standard 8-4-4-4-12 format, where:
 //   - The first digit of the third block is always '4', indicating Version 4.
-//   - The first digit of the fourth block is one of [8, 9, A, B]
+//   - The first digit of the fourth block is one of [8, 9, a, b]
 //     (binary `10xx`), indicating Variant 1 (RFC 4122).
 //
+// All hex digits are lowercase.
+//
 // The function returns an error if cryptographic randomness cannot be obtained.
 //
 // Deprecated: Use github.com/aatuh/randutil/uuid instead.
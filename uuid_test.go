@@ -0,0 +1,24 @@
+package uuid
+
+import (
+	"testing"
+)
+
+// testUUID is a fixed Version 4, Variant 1 UUID used across tests.
+const testUUID UUID = "6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d"
+
+// randomUUIDs returns n random Version 4, Variant 1 UUIDs in lowercase
+// canonical form.
+func randomUUIDs(t testing.TB, n int) []UUID {
+	t.Helper()
+	us := make([]UUID, n)
+	for i := range us {
+		b, err := random16()
+		if err != nil {
+			t.Fatal(err)
+		}
+		setVer4Var1(&b)
+		us[i] = encode(b)
+	}
+	return us
+}
@@ -0,0 +1,205 @@
+package uuid
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// Namespace UUIDs defined by RFC 4122 for name-based UUIDs.
+const (
+	NamespaceDNS  UUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	NamespaceURL  UUID = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	NamespaceOID  UUID = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	NamespaceX500 UUID = "6ba7b814-9dad-11d1-80b4-00c04fd430c8"
+)
+
+// Child derives a Version 5 (SHA-1 name-based) UUID for label within the
+// namespace given by the receiver. The result can itself be used as a
+// namespace, so hierarchies such as org/team/project form a deterministic
+// UUID tree in which path order matters.
+//
+// Parameters:
+//   - label: The name of the child.
+//
+// Returns:
+//   - UUID: The child UUID in lowercase canonical form.
+//   - error: An error if the receiver is not a valid UUID.
+func (u UUID) Child(label string) (UUID, error) {
+	ns, err := decode(u)
+	if err != nil {
+		return "", fmt.Errorf("Child: %w", err)
+	}
+	return encode(newV5(ns, []byte(label))), nil
+}
+
+// Namespace is a chainable handle on a namespace UUID that defers error
+// checking to the end of a chain, e.g.
+// NewNamespace(root).Child("org").Child("team").UUID().
+type Namespace struct {
+	id  UUID
+	err error
+}
+
+// NewNamespace starts a Namespace chain at root.
+//
+// Parameters:
+//   - root: The root namespace UUID.
+//
+// Returns:
+//   - Namespace: The chain handle.
+func NewNamespace(root UUID) Namespace {
+	return Namespace{id: root}
+}
+
+// Child descends to label using UUID.Child. Once an error occurs, further
+// calls are no-ops and the error is reported by UUID.
+//
+// Parameters:
+//   - label: The name of the child.
+//
+// Returns:
+//   - Namespace: The child namespace.
+func (n Namespace) Child(label string) Namespace {
+	if n.err != nil {
+		return n
+	}
+	id, err := n.id.Child(label)
+	return Namespace{id: id, err: err}
+}
+
+// UUID returns the namespace UUID at the end of the chain.
+//
+// Returns:
+//   - UUID: The namespace UUID.
+//   - error: The first error encountered in the chain.
+func (n Namespace) UUID() (UUID, error) {
+	return n.id, n.err
+}
+
+// Ver5FromReader computes the Version 5 UUID of the content of r within
+// namespace, streaming it through SHA-1 without buffering it in memory. The
+// result equals the Version 5 UUID of the same bytes used as a name, so
+// identical content always yields the same UUID.
+//
+// Parameters:
+//   - namespace: The namespace UUID.
+//   - r: The content to hash.
+//
+// Returns:
+//   - UUID: The content-addressed UUID in lowercase canonical form.
+//   - error: An error if namespace is not a valid UUID or reading r fails.
+func Ver5FromReader(namespace UUID, r io.Reader) (UUID, error) {
+	ns, err := decode(namespace)
+	if err != nil {
+		return "", fmt.Errorf("Ver5FromReader: %w", err)
+	}
+	h := sha1.New()
+	h.Write(ns[:])
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("Ver5FromReader: %w", err)
+	}
+	return encode(v5FromSum(h.Sum(nil))), nil
+}
+
+// Ver5FromMap computes a Version 5 UUID within namespace from the content of
+// m, independent of map iteration order. Keys are sorted bytewise and each
+// entry is serialized as the key and then the value, each preceded by its
+// length as a big-endian uint32; the concatenation is the v5 name. Because
+// of the length prefixes, distinct maps never serialize identically.
+//
+// Parameters:
+//   - namespace: The namespace UUID.
+//   - m: The attributes forming the natural key.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error if namespace is not a valid UUID.
+func Ver5FromMap(namespace UUID, m map[string]string) (UUID, error) {
+	ns, err := decode(namespace)
+	if err != nil {
+		return "", fmt.Errorf("Ver5FromMap: %w", err)
+	}
+	var name []byte
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		name = binary.BigEndian.AppendUint32(name, uint32(len(k)))
+		name = append(name, k...)
+		name = binary.BigEndian.AppendUint32(name, uint32(len(m[k])))
+		name = append(name, m[k]...)
+	}
+	return encode(newV5(ns, name)), nil
+}
+
+// Ver5Seq returns n Version 5 UUIDs within namespace for the names
+// baseName+"0", baseName+"1", ..., baseName+strconv.Itoa(n-1), so the i-th
+// UUID is always the v5 UUID of baseName followed by i in decimal.
+//
+// Parameters:
+//   - namespace: The namespace UUID.
+//   - baseName: The common name prefix.
+//   - n: The number of UUIDs.
+//
+// Returns:
+//   - []UUID: The UUIDs in lowercase canonical form, in index order.
+//   - error: An error if n is negative or namespace is not a valid UUID.
+func Ver5Seq(namespace UUID, baseName string, n int) ([]UUID, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("Ver5Seq: negative count: %d", n)
+	}
+	ns, err := decode(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Ver5Seq: %w", err)
+	}
+	out := make([]UUID, n)
+	for i := range out {
+		out[i] = encode(newV5(ns, []byte(baseName+strconv.Itoa(i))))
+	}
+	return out, nil
+}
+
+// DeriveFromPair derives a Version 5 UUID from an ordered pair, e.g. the
+// endpoints of a directed edge. SHA-1 is applied to a's 16 bytes followed
+// by b's 16 bytes, which is the v5 UUID of b's bytes as a name within a as
+// the namespace. The result is deterministic and order-sensitive:
+// swapping a and b yields an unrelated UUID.
+//
+// Parameters:
+//   - a: The first UUID.
+//   - b: The second UUID.
+//
+// Returns:
+//   - UUID: The derived UUID in lowercase canonical form.
+//   - error: An error if a or b is not a valid UUID.
+func DeriveFromPair(a, b UUID) (UUID, error) {
+	x, err := decode(a)
+	if err != nil {
+		return "", fmt.Errorf("DeriveFromPair: %w", err)
+	}
+	y, err := decode(b)
+	if err != nil {
+		return "", fmt.Errorf("DeriveFromPair: %w", err)
+	}
+	return encode(newV5(x, y[:])), nil
+}
+
+// newV5 computes the Version 5 UUID of name within namespace ns.
+func newV5(ns [16]byte, name []byte) [16]byte {
+	h := sha1.New()
+	h.Write(ns[:])
+	h.Write(name)
+	return v5FromSum(h.Sum(nil))
+}
+
+// v5FromSum shapes the first 16 bytes of a SHA-1 digest as a Version 5,
+// Variant 1 UUID.
+func v5FromSum(sum []byte) [16]byte {
+	var b [16]byte
+	copy(b[:], sum)
+	b[6] = b[6]&0x0f | 0x50
+	b[8] = b[8]&0x3f | 0x80
+	return b
+}
@@ -0,0 +1,219 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestChildKnownValue(t *testing.T) {
+	const dns UUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	got, err := dns.Child("www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("2ed6657d-e927-568b-95e1-2665a8aea6a2"); got != want {
+		t.Errorf("Child() = %s, want %s", got, want)
+	}
+}
+
+func TestChildPathOrderMatters(t *testing.T) {
+	orgTeam, err := NewNamespace(testUUID).Child("org").Child("team").UUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teamOrg, err := NewNamespace(testUUID).Child("team").Child("org").UUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orgTeam == teamOrg {
+		t.Errorf("org/team and team/org both yield %s", orgTeam)
+	}
+	org, _ := testUUID.Child("org")
+	direct, _ := org.Child("team")
+	if direct != orgTeam {
+		t.Errorf("chained Child() = %s, want %s", orgTeam, direct)
+	}
+	again, _ := NewNamespace(testUUID).Child("org").Child("team").UUID()
+	if again != orgTeam {
+		t.Errorf("path not deterministic: %s != %s", again, orgTeam)
+	}
+}
+
+func TestNamespaceError(t *testing.T) {
+	if _, err := UUID("not-a-uuid").Child("x"); err == nil {
+		t.Error("invalid namespace: expected error")
+	}
+	if _, err := NewNamespace("not-a-uuid").Child("org").Child("team").UUID(); err == nil {
+		t.Error("invalid root: expected error from the end of the chain")
+	}
+}
+
+func TestVer5FromReader(t *testing.T) {
+	content := bytes.Repeat([]byte("content-addressed "), 10000)
+	got, err := Ver5FromReader(testUUID, iotest.OneByteReader(bytes.NewReader(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, _ := decode(testUUID)
+	if want := encode(newV5(ns, content)); got != want {
+		t.Errorf("Ver5FromReader() = %s, want %s", got, want)
+	}
+	const dns UUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	got, err = Ver5FromReader(dns, strings.NewReader("www.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID("2ed6657d-e927-568b-95e1-2665a8aea6a2"); got != want {
+		t.Errorf("Ver5FromReader() = %s, want %s", got, want)
+	}
+}
+
+func TestVer5FromReaderErrors(t *testing.T) {
+	errRead := errors.New("read failed")
+	if _, err := Ver5FromReader(testUUID, iotest.ErrReader(errRead)); !errors.Is(err, errRead) {
+		t.Errorf("read error: got %v, want %v", err, errRead)
+	}
+	if _, err := Ver5FromReader("not-a-uuid", strings.NewReader("x")); err == nil {
+		t.Error("invalid namespace: expected error")
+	}
+}
+
+func TestVer5FromMapOrderIndependent(t *testing.T) {
+	keys := []string{"tenant", "region", "sku", "a", "b", "c", "d", "e"}
+	forward := make(map[string]string)
+	for _, k := range keys {
+		forward[k] = "v-" + k
+	}
+	backward := make(map[string]string)
+	for i := len(keys) - 1; i >= 0; i-- {
+		backward[keys[i]] = "v-" + keys[i]
+	}
+	a, err := Ver5FromMap(testUUID, forward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Ver5FromMap(testUUID, backward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("maps with the same content yield %s and %s", a, b)
+	}
+	if !isCanonical(string(a)) || a[14] != '5' {
+		t.Errorf("Ver5FromMap() = %s, want a canonical Version 5 UUID", a)
+	}
+}
+
+func TestVer5FromMapDistinct(t *testing.T) {
+	maps := []map[string]string{
+		{},
+		{"ab": "c"},
+		{"a": "bc"},
+		{"a": "b", "c": ""},
+		{"a": "b"},
+	}
+	seen := make(map[UUID]int)
+	for i, m := range maps {
+		u, err := Ver5FromMap(testUUID, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j, ok := seen[u]; ok {
+			t.Errorf("maps %d and %d collide: %s", j, i, u)
+		}
+		seen[u] = i
+	}
+	if _, err := Ver5FromMap("not-a-uuid", nil); err == nil {
+		t.Error("invalid namespace: expected error")
+	}
+}
+
+func TestVer5Seq(t *testing.T) {
+	a, err := Ver5Seq(NamespaceDNS, "fixture-", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Ver5Seq(NamespaceDNS, "fixture-", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(a, b) {
+		t.Error("Ver5Seq() is not reproducible")
+	}
+	seen := make(map[UUID]bool, len(a))
+	for i, u := range a {
+		want, err := NamespaceDNS.Child("fixture-" + strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != want {
+			t.Errorf("Ver5Seq()[%d] = %s, want the v5 UUID of %q: %s", i, u, "fixture-"+strconv.Itoa(i), want)
+		}
+		if seen[u] {
+			t.Errorf("Ver5Seq()[%d] = %s repeats an earlier element", i, u)
+		}
+		seen[u] = true
+	}
+	other, _ := Ver5Seq(NamespaceDNS, "other-", 1)
+	if other[0] == a[0] {
+		t.Error("different base names yield the same UUID")
+	}
+}
+
+func TestVer5SeqEdgeCases(t *testing.T) {
+	if got, err := Ver5Seq(NamespaceURL, "x", 0); err != nil || len(got) != 0 {
+		t.Errorf("Ver5Seq(0) = %v, %v; want empty", got, err)
+	}
+	if _, err := Ver5Seq(NamespaceURL, "x", -1); err == nil {
+		t.Error("negative count: expected error")
+	}
+	if _, err := Ver5Seq("not-a-uuid", "x", 1); err == nil {
+		t.Error("invalid namespace: expected error")
+	}
+}
+
+func TestDeriveFromPair(t *testing.T) {
+	a, b := testUUID, NamespaceDNS
+	ab, err := DeriveFromPair(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ba, err := DeriveFromPair(b, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ab == ba {
+		t.Errorf("DeriveFromPair is symmetric: both orders yield %s", ab)
+	}
+	again, _ := DeriveFromPair(UUID(strings.ToUpper(string(a))), b)
+	if again != ab {
+		t.Errorf("DeriveFromPair not deterministic: %s != %s", again, ab)
+	}
+	if !isCanonical(string(ab)) || ab[14] != '5' {
+		t.Errorf("DeriveFromPair() = %s, want a canonical Version 5 UUID", ab)
+	}
+	bBytes, _ := decode(b)
+	viaName, _ := DeriveFromPair(a, b)
+	aBytes, _ := decode(a)
+	if want := encode(newV5(aBytes, bBytes[:])); viaName != want {
+		t.Errorf("DeriveFromPair() = %s, want the v5 UUID of b within a: %s", viaName, want)
+	}
+	self, _ := DeriveFromPair(a, a)
+	if self == ab || self == ba {
+		t.Error("DeriveFromPair(a, a) collides with a different pair")
+	}
+}
+
+func TestDeriveFromPairErrors(t *testing.T) {
+	if _, err := DeriveFromPair("not-a-uuid", testUUID); err == nil {
+		t.Error("invalid first UUID: expected error")
+	}
+	if _, err := DeriveFromPair(testUUID, "not-a-uuid"); err == nil {
+		t.Error("invalid second UUID: expected error")
+	}
+}
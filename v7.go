@@ -0,0 +1,202 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// batchCounterBits is the width of the counter BatchVer7 places in the
+// random region: the 12 bits of rand_a followed by the top 30 bits of
+// rand_b.
+const batchCounterBits = 42
+
+// BatchVer7 generates n Version 7 (time-ordered) UUIDs that all carry the
+// same Unix millisecond timestamp. To keep them unique and strictly ordered
+// within the batch, a 42-bit counter occupies rand_a and the top 30 bits of
+// rand_b; it starts at a random value below 2^41 and increments by one per
+// UUID. The remaining 32 bits of rand_b are random for each UUID.
+//
+// Because the timestamp leads, the whole batch sorts before any UUID minted
+// in a later millisecond.
+//
+// Parameters:
+//   - n: The number of UUIDs to generate.
+//
+// Returns:
+//   - []UUID: The UUIDs in strictly increasing order.
+//   - error: An error if n is negative or crypto/rand fails.
+func BatchVer7(n int) ([]UUID, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("BatchVer7: negative count: %d", n)
+	}
+	seed, err := random16()
+	if err != nil {
+		return nil, fmt.Errorf("BatchVer7: %w", err)
+	}
+	start := binary.BigEndian.Uint64(seed[:8]) >> (64 - (batchCounterBits - 1))
+	ms := time.Now().UnixMilli()
+	out := make([]UUID, n)
+	for i := range out {
+		b, err := random16()
+		if err != nil {
+			return nil, fmt.Errorf("BatchVer7: %w", err)
+		}
+		counter := start + uint64(i)
+		putV7Timestamp(&b, ms)
+		// rand_a holds the top 12 counter bits.
+		b[6] = 0x70 | byte(counter>>38)&0x0f
+		b[7] = byte(counter >> 30)
+		// The variant is followed by the low 30 counter bits.
+		b[8] = 0x80 | byte(counter>>24)&0x3f
+		b[9] = byte(counter >> 16)
+		b[10] = byte(counter >> 8)
+		b[11] = byte(counter)
+		out[i] = encode(b)
+	}
+	return out, nil
+}
+
+// putV7Timestamp writes ms as the 48-bit big-endian unix_ts_ms field of b.
+func putV7Timestamp(b *[16]byte, ms int64) {
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(ms))
+	copy(b[0:6], ts[2:8])
+}
+
+// IsAfter reports whether the timestamp embedded in a Version 7 UUID is
+// after t. Timestamps have millisecond precision.
+//
+// Parameters:
+//   - t: The time to compare against.
+//
+// Returns:
+//   - bool: True if the UUID was minted after t.
+//   - error: An error if the receiver is not a valid Version 7 UUID.
+func (u UUID) IsAfter(t time.Time) (bool, error) {
+	ts, err := v7Time(u)
+	if err != nil {
+		return false, fmt.Errorf("IsAfter: %w", err)
+	}
+	return ts.After(t), nil
+}
+
+// Age returns how long ago a Version 7 UUID was minted according to its
+// embedded timestamp. The result is negative if the timestamp lies in the
+// future.
+//
+// Returns:
+//   - time.Duration: The time elapsed since the embedded timestamp.
+//   - error: An error if the receiver is not a valid Version 7 UUID.
+func (u UUID) Age() (time.Duration, error) {
+	ts, err := v7Time(u)
+	if err != nil {
+		return 0, fmt.Errorf("Age: %w", err)
+	}
+	return time.Since(ts), nil
+}
+
+// v7Time decodes u and returns its embedded unix_ts_ms timestamp.
+func v7Time(u UUID) (time.Time, error) {
+	b, err := decodeV7(u)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(v7Millis(b)), nil
+}
+
+// decodeV7 decodes u and checks that it is a Version 7 UUID.
+func decodeV7(u UUID) ([16]byte, error) {
+	b, err := decode(u)
+	if err != nil {
+		return b, err
+	}
+	if v := b[6] >> 4; v != 7 {
+		return b, fmt.Errorf("expected version 7 UUID, got version %d: %s", v, u)
+	}
+	return b, nil
+}
+
+// v7Millis returns the 48-bit unix_ts_ms field of b.
+func v7Millis(b [16]byte) int64 {
+	var ts [8]byte
+	copy(ts[2:8], b[0:6])
+	return int64(binary.BigEndian.Uint64(ts[:]))
+}
+
+// Ver7WithSeq generates a Version 7 UUID for the current millisecond that
+// carries a caller-supplied sequence number for tie-breaking within the
+// millisecond. The 12-bit rand_a field is zero and seq occupies the top 32
+// bits of the 62-bit rand_b field, directly after the variant bits; the low
+// 30 bits of rand_b are random. UUIDs from the same millisecond therefore
+// sort by seq, so a monotonically increasing external counter yields
+// strictly ordered keys.
+//
+// Parameters:
+//   - seq: The sequence number.
+//
+// Returns:
+//   - UUID: A Version 7 UUID carrying seq.
+//   - error: An error if crypto/rand fails.
+func Ver7WithSeq(seq uint32) (UUID, error) {
+	r, err := random16()
+	if err != nil {
+		return "", fmt.Errorf("Ver7WithSeq: %w", err)
+	}
+	var b [16]byte
+	putV7Timestamp(&b, time.Now().UnixMilli())
+	b[6] = 0x70
+	lo := 0x80<<56 | uint64(seq)<<30 | binary.BigEndian.Uint64(r[8:])&(1<<30-1)
+	binary.BigEndian.PutUint64(b[8:], lo)
+	return encode(b), nil
+}
+
+// Seq returns the sequence number embedded by Ver7WithSeq.
+//
+// Returns:
+//   - uint32: The sequence number.
+//   - error: An error if the receiver is not a valid Version 7 UUID.
+func (u UUID) Seq() (uint32, error) {
+	b, err := decodeV7(u)
+	if err != nil {
+		return 0, fmt.Errorf("Seq: %w", err)
+	}
+	return uint32(binary.BigEndian.Uint64(b[8:]) >> 30), nil
+}
+
+// BucketByWindow groups Version 7 UUIDs into fixed time windows by their
+// embedded timestamps, each of which is decoded once. Windows are aligned
+// to the Unix epoch and each bucket is keyed by the Unix time of its
+// window's start, in milliseconds. Within a bucket, UUIDs keep their input
+// order.
+//
+// Parameters:
+//   - us: The Version 7 UUIDs to group.
+//   - window: The window length. Must be a positive whole number of
+//     milliseconds.
+//
+// Returns:
+//   - map[int64][]UUID: The UUIDs keyed by window start in Unix
+//     milliseconds.
+//   - error: An error if window is not a positive whole number of
+//     milliseconds or any element is not a valid Version 7 UUID.
+func BucketByWindow(us []UUID, window time.Duration) (map[int64][]UUID, error) {
+	if window <= 0 || window%time.Millisecond != 0 {
+		return nil, fmt.Errorf(
+			"BucketByWindow: window must be a positive whole number of milliseconds: %s",
+			window,
+		)
+	}
+	w := window.Milliseconds()
+	out := make(map[int64][]UUID)
+	for i, u := range us {
+		b, err := decodeV7(u)
+		if err != nil {
+			return nil, fmt.Errorf("BucketByWindow: element %d: %w", i, err)
+		}
+		ms := v7Millis(b)
+		start := ms - ms%w
+		out[start] = append(out[start], u)
+	}
+	return out, nil
+}
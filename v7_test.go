@@ -0,0 +1,161 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBatchVer7OrderedSharedTimestamp(t *testing.T) {
+	us, err := BatchVer7(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(us) != 1000 {
+		t.Fatalf("got %d UUIDs, want 1000", len(us))
+	}
+	first, err := decodeV7(us[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(us); i++ {
+		if us[i-1] >= us[i] {
+			t.Fatalf("not strictly increasing at %d: %s >= %s", i, us[i-1], us[i])
+		}
+		b, err := decodeV7(us[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v7Millis(b) != v7Millis(first) {
+			t.Fatalf("UUID %d has timestamp %d, want %d", i, v7Millis(b), v7Millis(first))
+		}
+		if b[8]&0xc0 != 0x80 {
+			t.Fatalf("UUID %d has wrong variant: %s", i, us[i])
+		}
+	}
+}
+
+func TestBatchVer7Counts(t *testing.T) {
+	us, err := BatchVer7(0)
+	if err != nil || len(us) != 0 {
+		t.Errorf("BatchVer7(0) = %v, %v; want empty", us, err)
+	}
+	if _, err := BatchVer7(-1); err == nil {
+		t.Error("BatchVer7(-1): expected error")
+	}
+}
+
+// v7At returns a random Version 7 UUID carrying the timestamp of ts.
+func v7At(t testing.TB, ts time.Time) UUID {
+	t.Helper()
+	b, err := random16()
+	if err != nil {
+		t.Fatal(err)
+	}
+	putV7Timestamp(&b, ts.UnixMilli())
+	b[6] = b[6]&0x0f | 0x70
+	b[8] = b[8]&0x3f | 0x80
+	return encode(b)
+}
+
+func TestIsAfterAndAge(t *testing.T) {
+	now := time.Now()
+	past := v7At(t, now.Add(-time.Hour))
+	future := v7At(t, now.Add(time.Hour))
+
+	if after, err := past.IsAfter(now); err != nil || after {
+		t.Errorf("past.IsAfter(now) = %v, %v; want false", after, err)
+	}
+	if after, err := future.IsAfter(now); err != nil || !after {
+		t.Errorf("future.IsAfter(now) = %v, %v; want true", after, err)
+	}
+	if age, err := past.Age(); err != nil || age < 59*time.Minute || age > 61*time.Minute {
+		t.Errorf("past.Age() = %s, %v; want about 1h", age, err)
+	}
+	if age, err := future.Age(); err != nil || age > -59*time.Minute {
+		t.Errorf("future.Age() = %s, %v; want about -1h", age, err)
+	}
+}
+
+func TestIsAfterRejectsNonV7(t *testing.T) {
+	if _, err := testUUID.IsAfter(time.Now()); err == nil {
+		t.Error("IsAfter on v4 UUID: expected error")
+	}
+	if _, err := testUUID.Age(); err == nil {
+		t.Error("Age on v4 UUID: expected error")
+	}
+}
+
+func TestVer7WithSeqSortsBySequence(t *testing.T) {
+	seqs := []uint32{0, 1, 2, 1 << 16, 1<<32 - 1}
+	for range 10 {
+		us := make([]UUID, len(seqs))
+		for i := len(seqs) - 1; i >= 0; i-- {
+			u, err := Ver7WithSeq(seqs[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := u.Seq(); err != nil || got != seqs[i] {
+				t.Fatalf("%s.Seq() = %d, %v; want %d", u, got, err, seqs[i])
+			}
+			us[i] = u
+		}
+		first, _ := decodeV7(us[0])
+		last, _ := decodeV7(us[len(us)-1])
+		if v7Millis(first) != v7Millis(last) {
+			continue // the clock ticked between calls; try again
+		}
+		for i := 1; i < len(us); i++ {
+			if us[i-1] >= us[i] {
+				t.Errorf("seq %d sorts after seq %d: %s >= %s", seqs[i-1], seqs[i], us[i-1], us[i])
+			}
+		}
+		return
+	}
+	t.Skip("no run of UUIDs landed in a single millisecond")
+}
+
+func TestSeqErrors(t *testing.T) {
+	if _, err := testUUID.Seq(); err == nil {
+		t.Error("v4 UUID: expected error")
+	}
+}
+
+func TestBucketByWindow(t *testing.T) {
+	base := time.UnixMilli(1_700_000_000_000).Truncate(time.Minute)
+	a := v7At(t, base)
+	b := v7At(t, base.Add(59*time.Second))
+	c := v7At(t, base.Add(time.Minute))
+	d := v7At(t, base.Add(3*time.Minute+time.Millisecond))
+	e := v7At(t, base.Add(30*time.Second))
+	got, err := BucketByWindow([]UUID{a, b, c, d, e}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := base.UnixMilli()
+	want := map[int64][]UUID{
+		start:           {a, b, e},
+		start + 60_000:  {c},
+		start + 180_000: {d},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("BucketByWindow() has %d buckets, want %d: %v", len(got), len(want), got)
+	}
+	for k, us := range want {
+		if !slices.Equal(got[k], us) {
+			t.Errorf("bucket %d = %v, want %v", k, got[k], us)
+		}
+	}
+}
+
+func TestBucketByWindowErrors(t *testing.T) {
+	us := []UUID{v7At(t, time.Now())}
+	for _, w := range []time.Duration{0, -time.Second, time.Microsecond, 1500 * time.Microsecond} {
+		if _, err := BucketByWindow(us, w); err == nil {
+			t.Errorf("window %s: expected error", w)
+		}
+	}
+	if _, err := BucketByWindow([]UUID{testUUID}, time.Minute); err == nil {
+		t.Error("v4 element: expected error")
+	}
+}
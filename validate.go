@@ -0,0 +1,227 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// generationFormatRegex matches the exact output format of the package's
+// generators: version 4, Variant 1 and lowercase hex digits throughout.
+var generationFormatRegex = regexp.MustCompile(
+	`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`,
+)
+
+// ErrDenied is returned by ValidateNotDenied when a UUID is on the
+// denylist.
+var ErrDenied = errors.New("UUID is denied")
+
+// ErrStale is returned by ValidateFreshV7 when a UUID's timestamp is older
+// than the allowed age.
+var ErrStale = errors.New("UUID is stale")
+
+// ErrFuture is returned by ValidateFreshV7 when a UUID's timestamp lies
+// further in the future than the allowed clock skew.
+var ErrFuture = errors.New("UUID is from the future")
+
+// ErrReservedNamespace is returned by ValidateNotNamespace when a UUID is
+// one of the standard namespace UUIDs.
+var ErrReservedNamespace = errors.New("UUID is a reserved namespace")
+
+// ErrReserved is returned by ValidateNotReserved when a UUID is one of the
+// reserved IDs.
+var ErrReserved = errors.New("UUID is reserved")
+
+// ValidateNotDenied parses s, canonicalizes it to lowercase hyphenated form
+// and rejects it if the result is a key of denylist. The canonical, braced,
+// URN and compact forms are accepted in any case, so matching is
+// case-insensitive. Denylist keys must themselves be canonical.
+//
+// Parameters:
+//   - s: The string to validate.
+//   - denylist: The set of denied canonical UUIDs.
+//
+// Returns:
+//   - UUID: The canonical UUID.
+//   - error: An error wrapping ErrDenied if the UUID is denied, or an
+//     error if s is not a valid UUID.
+func ValidateNotDenied(s string, denylist map[UUID]struct{}) (UUID, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return "", fmt.Errorf("ValidateNotDenied: %w", err)
+	}
+	u := encode(b)
+	if _, ok := denylist[u]; ok {
+		return "", fmt.Errorf("ValidateNotDenied: %w: %s", ErrDenied, u)
+	}
+	return u, nil
+}
+
+// ValidateFreshV7 parses s as a Version 7 UUID, in canonical, braced, URN
+// or compact form, and checks that its embedded timestamp is no older than
+// maxAge and no more than clockSkew in the future relative to the current
+// time. Timestamps have millisecond precision.
+//
+// Parameters:
+//   - s: The string to validate.
+//   - maxAge: The maximum accepted age.
+//   - clockSkew: The maximum accepted lead of the timestamp over the clock.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error wrapping ErrStale or ErrFuture if the timestamp is out
+//     of bounds, or an error if s is not a valid Version 7 UUID.
+func ValidateFreshV7(s string, maxAge time.Duration, clockSkew time.Duration) (UUID, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return "", fmt.Errorf("ValidateFreshV7: %w", err)
+	}
+	u := encode(b)
+	ts, err := v7Time(u)
+	if err != nil {
+		return "", fmt.Errorf("ValidateFreshV7: %w", err)
+	}
+	age := time.Since(ts)
+	if age > maxAge {
+		return "", fmt.Errorf("ValidateFreshV7: %w: %s is %s old", ErrStale, u, age)
+	}
+	if -age > clockSkew {
+		return "", fmt.Errorf(
+			"ValidateFreshV7: %w: %s is %s ahead", ErrFuture, u, -age,
+		)
+	}
+	return u, nil
+}
+
+// ValidateNotNamespace parses s, in canonical, braced, URN or compact form,
+// and rejects it if it is one of the standard namespace UUIDs NamespaceDNS,
+// NamespaceURL, NamespaceOID or NamespaceX500. This catches namespace
+// constants leaking into entity IDs.
+//
+// Parameters:
+//   - s: The string to validate.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error wrapping ErrReservedNamespace if s is a namespace
+//     UUID, or an error if s is not a valid UUID.
+func ValidateNotNamespace(s string) (UUID, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return "", fmt.Errorf("ValidateNotNamespace: %w", err)
+	}
+	u := encode(b)
+	switch u {
+	case NamespaceDNS, NamespaceURL, NamespaceOID, NamespaceX500:
+		return "", fmt.Errorf(
+			"ValidateNotNamespace: %w: %s", ErrReservedNamespace, u,
+		)
+	}
+	return u, nil
+}
+
+// MatchesGenerationFormat reports whether s is exactly in the form emitted
+// by Ver4Var1 and the package's other Version 4 generators: a hyphenated
+// Version 4, Variant 1 UUID with lowercase hex digits, including the
+// variant digit (the first of the fourth block), which is one of 8, 9, a
+// or b. It is stricter than IsValid, rejecting braced, URN, compact and
+// uppercase forms, and catches IDs minted by a differently configured
+// source.
+//
+// Parameters:
+//   - s: The string to check.
+//
+// Returns:
+//   - bool: True if s matches the Ver4Var1 output format.
+func MatchesGenerationFormat(s string) bool {
+	return generationFormatRegex.MatchString(s)
+}
+
+// ValidateNotReserved parses s, in canonical, braced, URN or compact form,
+// and rejects it if it equals one of the reserved IDs, such as built-in
+// system IDs. Unlike ValidateNotDenied, it takes a slice and compares raw
+// bytes with a linear scan, which suits small reserved sets; reserved
+// entries may be in any case, and invalid entries are ignored.
+//
+// Parameters:
+//   - s: The string to validate.
+//   - reserved: The reserved UUIDs.
+//
+// Returns:
+//   - UUID: The UUID in lowercase canonical form.
+//   - error: An error wrapping ErrReserved if the UUID is reserved, or an
+//     error if s is not a valid UUID.
+func ValidateNotReserved(s string, reserved []UUID) (UUID, error) {
+	b, err := decodeLenient(s)
+	if err != nil {
+		return "", fmt.Errorf("ValidateNotReserved: %w", err)
+	}
+	for _, r := range reserved {
+		if rb, err := decode(r); err == nil && rb == b {
+			return "", fmt.Errorf(
+				"ValidateNotReserved: %w: %s", ErrReserved, encode(b),
+			)
+		}
+	}
+	return encode(b), nil
+}
+
+// ValidateFormatUUID implements the JSON Schema "format": "uuid" check for a
+// value delivered by a JSON decoder, for registration in a schema engine's
+// format registry. The value must be a string holding a UUID in the
+// hyphenated 8-4-4-4-12 form of RFC 4122, in either case; any version and
+// variant is accepted, as the format imposes none. Braced, URN and compact
+// forms are rejected.
+//
+// Parameters:
+//   - value: The decoded JSON value.
+//
+// Returns:
+//   - error: An error describing why value is not a UUID string, or nil.
+func ValidateFormatUUID(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("ValidateFormatUUID: expected string, got %T", value)
+	}
+	if _, err := decode(UUID(s)); err != nil {
+		return fmt.Errorf("ValidateFormatUUID: %w", err)
+	}
+	return nil
+}
+
+// ValidateMapKeys audits a map keyed by UUID strings, such as a hand-edited
+// config, and returns the keys that are not valid UUIDs in lowercase
+// canonical form. Keys are checked by the byte-level decoder rather than a
+// regular expression, so large maps are cheap to audit.
+//
+// Parameters:
+//   - m: The map to audit.
+//
+// Returns:
+//   - []string: The offending keys in sorted order, or an empty slice if
+//     every key is canonical.
+func ValidateMapKeys[V any](m map[string]V) []string {
+	bad := []string{}
+	for k := range m {
+		if !isCanonical(k) {
+			bad = append(bad, k)
+		}
+	}
+	slices.Sort(bad)
+	return bad
+}
+
+// isCanonical reports whether s is a UUID in lowercase canonical form.
+func isCanonical(s string) bool {
+	if _, err := decode(UUID(s)); err != nil {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 'A' && s[i] <= 'F' {
+			return false
+		}
+	}
+	return true
+}
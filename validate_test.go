@@ -0,0 +1,247 @@
+package uuid
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateNotDenied(t *testing.T) {
+	denylist := map[UUID]struct{}{
+		"00000000-0000-0000-0000-000000000000": {},
+		testUUID:                               {},
+	}
+	denied := []string{
+		"00000000-0000-0000-0000-000000000000",
+		string(testUUID),
+		strings.ToUpper(string(testUUID)),
+		"{" + string(testUUID) + "}",
+		"urn:uuid:" + string(testUUID),
+		strings.ReplaceAll(string(testUUID), "-", ""),
+	}
+	for _, s := range denied {
+		if _, err := ValidateNotDenied(s, denylist); !errors.Is(err, ErrDenied) {
+			t.Errorf("ValidateNotDenied(%q) error = %v, want ErrDenied", s, err)
+		}
+	}
+	allowed := "6F1A0B1C-8D7E-4A2B-8C9D-1E2F3A4B5C6E"
+	got, err := ValidateNotDenied(allowed, denylist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := UUID(strings.ToLower(allowed)); got != want {
+		t.Errorf("ValidateNotDenied(%q) = %s, want %s", allowed, got, want)
+	}
+	_, err = ValidateNotDenied("not-a-uuid", denylist)
+	if err == nil || errors.Is(err, ErrDenied) {
+		t.Errorf("invalid input: error = %v, want a parse error", err)
+	}
+}
+
+func TestValidateFreshV7(t *testing.T) {
+	now := time.Now()
+	fresh := v7At(t, now.Add(-time.Minute))
+	got, err := ValidateFreshV7(strings.ToUpper(string(fresh)), time.Hour, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != fresh {
+		t.Errorf("ValidateFreshV7() = %s, want %s", got, fresh)
+	}
+	slightlyAhead := v7At(t, now.Add(10*time.Second))
+	if _, err := ValidateFreshV7(string(slightlyAhead), time.Hour, time.Minute); err != nil {
+		t.Errorf("within clock skew: %v", err)
+	}
+	stale := v7At(t, now.Add(-2*time.Hour))
+	if _, err := ValidateFreshV7(string(stale), time.Hour, time.Minute); !errors.Is(err, ErrStale) {
+		t.Errorf("stale: error = %v, want ErrStale", err)
+	}
+	future := v7At(t, now.Add(time.Hour))
+	if _, err := ValidateFreshV7(string(future), time.Hour, time.Minute); !errors.Is(err, ErrFuture) {
+		t.Errorf("future: error = %v, want ErrFuture", err)
+	}
+}
+
+func TestValidateFreshV7Errors(t *testing.T) {
+	for _, s := range []string{string(testUUID), "not-a-uuid"} {
+		_, err := ValidateFreshV7(s, time.Hour, time.Minute)
+		if err == nil || errors.Is(err, ErrStale) || errors.Is(err, ErrFuture) {
+			t.Errorf("ValidateFreshV7(%q) error = %v, want a parse error", s, err)
+		}
+	}
+}
+
+func TestValidateNotNamespace(t *testing.T) {
+	namespaces := map[string]UUID{
+		"DNS":  NamespaceDNS,
+		"URL":  NamespaceURL,
+		"OID":  NamespaceOID,
+		"X500": NamespaceX500,
+	}
+	for name, ns := range namespaces {
+		for _, s := range []string{string(ns), strings.ToUpper(string(ns)), "{" + string(ns) + "}"} {
+			if _, err := ValidateNotNamespace(s); !errors.Is(err, ErrReservedNamespace) {
+				t.Errorf("%s: ValidateNotNamespace(%q) error = %v, want ErrReservedNamespace", name, s, err)
+			}
+		}
+	}
+	got, err := ValidateNotNamespace(strings.ToUpper(string(testUUID)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != testUUID {
+		t.Errorf("ValidateNotNamespace() = %s, want %s", got, testUUID)
+	}
+	near := "6ba7b813-9dad-11d1-80b4-00c04fd430c8"
+	if _, err := ValidateNotNamespace(near); err != nil {
+		t.Errorf("ValidateNotNamespace(%q): %v", near, err)
+	}
+	_, err = ValidateNotNamespace("not-a-uuid")
+	if err == nil || errors.Is(err, ErrReservedNamespace) {
+		t.Errorf("invalid input: error = %v, want a parse error", err)
+	}
+}
+
+func TestMatchesGenerationFormat(t *testing.T) {
+	for range 100 {
+		u, err := Ver4Var1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !MatchesGenerationFormat(string(u)) {
+			t.Errorf("MatchesGenerationFormat(%q) = false for Ver4Var1 output", u)
+		}
+	}
+	for _, variant := range []byte{'a', 'b'} {
+		u, err := Ver4Where(func(u UUID) bool { return u[19] == variant }, 10000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !MatchesGenerationFormat(string(u)) {
+			t.Errorf("MatchesGenerationFormat(%q) = false for Ver4Where output", u)
+		}
+	}
+	for _, s := range []string{
+		"6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-4a2b-bc9d-1e2f3a4b5c6d",
+	} {
+		if !MatchesGenerationFormat(s) {
+			t.Errorf("MatchesGenerationFormat(%q) = false, want true", s)
+		}
+	}
+	for _, s := range []string{
+		strings.ToUpper(string(testUUID)),
+		"{" + string(testUUID) + "}",
+		"urn:uuid:" + string(testUUID),
+		strings.ReplaceAll(string(testUUID), "-", ""),
+		"6f1a0b1c-8d7e-4a2b-Bc9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-4a2b-Ac9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-7a2b-8c9d-1e2f3a4b5c6d",
+		"6f1a0b1c-8d7e-4a2b-cc9d-1e2f3a4b5c6d",
+		"",
+	} {
+		if MatchesGenerationFormat(s) {
+			t.Errorf("MatchesGenerationFormat(%q) = true, want false", s)
+		}
+	}
+}
+
+func TestValidateFormatUUID(t *testing.T) {
+	valid := []any{
+		string(testUUID),
+		strings.ToUpper(string(testUUID)),
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"00000000-0000-0000-0000-000000000000",
+	}
+	for _, v := range valid {
+		if err := ValidateFormatUUID(v); err != nil {
+			t.Errorf("ValidateFormatUUID(%#v): %v", v, err)
+		}
+	}
+	invalid := []any{
+		nil,
+		42.0,
+		true,
+		[]any{string(testUUID)},
+		"",
+		"not-a-uuid",
+		"{" + string(testUUID) + "}",
+		strings.ReplaceAll(string(testUUID), "-", ""),
+	}
+	for _, v := range invalid {
+		if err := ValidateFormatUUID(v); err == nil {
+			t.Errorf("ValidateFormatUUID(%#v): expected error", v)
+		}
+	}
+	if err := ValidateFormatUUID(nil); err == nil || !strings.Contains(err.Error(), "expected string") {
+		t.Errorf("null: error = %v, want a type error", err)
+	}
+}
+
+func TestValidateMapKeys(t *testing.T) {
+	clean := map[string]int{}
+	for i, u := range randomUUIDs(t, 10) {
+		clean[string(u)] = i
+	}
+	if got := ValidateMapKeys(clean); got == nil || len(got) != 0 {
+		t.Errorf("clean map: ValidateMapKeys() = %#v, want an empty slice", got)
+	}
+	upper := strings.ToUpper(string(testUUID))
+	dirty := map[string]bool{
+		string(testUUID):                       true,
+		upper:                                  true,
+		"{" + string(testUUID) + "}":           true,
+		string(testUUID)[:35]:                  true,
+		"6f1a0b1c_8d7e_4a2b_8c9d_1e2f3a4b5c6d": true,
+		"":                                     true,
+	}
+	got := ValidateMapKeys(dirty)
+	want := []string{
+		"",
+		string(testUUID)[:35],
+		upper,
+		"6f1a0b1c_8d7e_4a2b_8c9d_1e2f3a4b5c6d",
+		"{" + string(testUUID) + "}",
+	}
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("dirty map: ValidateMapKeys() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateNotReserved(t *testing.T) {
+	admin := UUID("00000000-0000-4000-8000-000000000001")
+	system := UUID("00000000-0000-4000-8000-0000000000AB")
+	reserved := []UUID{"not-a-uuid", admin, system}
+	for _, s := range []string{
+		string(admin),
+		strings.ToUpper(string(admin)),
+		"{" + string(admin) + "}",
+		"urn:uuid:" + string(admin),
+		strings.ReplaceAll(string(admin), "-", ""),
+		strings.ToLower(string(system)),
+	} {
+		if _, err := ValidateNotReserved(s, reserved); !errors.Is(err, ErrReserved) {
+			t.Errorf("ValidateNotReserved(%q) error = %v, want ErrReserved", s, err)
+		}
+	}
+	for _, s := range []string{
+		strings.ToUpper(string(testUUID)),
+		"{" + string(testUUID) + "}",
+		strings.ReplaceAll(string(testUUID), "-", ""),
+	} {
+		got, err := ValidateNotReserved(s, reserved)
+		if err != nil || got != testUUID {
+			t.Errorf("ValidateNotReserved(%q) = %s, %v; want %s", s, got, err, testUUID)
+		}
+	}
+	if got, err := ValidateNotReserved(string(admin), nil); err != nil || got != admin {
+		t.Errorf("empty reserved list: got %s, %v; want %s", got, err, admin)
+	}
+	_, err := ValidateNotReserved("not-a-uuid", reserved)
+	if err == nil || errors.Is(err, ErrReserved) {
+		t.Errorf("invalid input: error = %v, want a parse error", err)
+	}
+}
@@ -0,0 +1,126 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Identicon returns a size×size grid derived from the UUID, indexed as
+// grid[row][col], suitable for rendering a GitHub-style identicon. The left
+// ceil(size/2) columns are filled from a SHA-256 bit stream of the UUID's
+// bytes and mirrored onto the right, so the grid is left-right symmetric.
+// The same UUID and size always yield the same grid.
+//
+// Parameters:
+//   - size: The number of rows and columns.
+//
+// Returns:
+//   - [][]bool: The grid, where true cells are "on".
+//   - error: An error if size is not positive or the receiver is not a
+//     valid UUID.
+func (u UUID) Identicon(size int) ([][]bool, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("Identicon: size must be positive: %d", size)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return nil, fmt.Errorf("Identicon: %w", err)
+	}
+	half := (size + 1) / 2
+	bits := expand(b, "identicon", (size*half+7)/8)
+	grid := make([][]bool, size)
+	for row := range grid {
+		grid[row] = make([]bool, size)
+		for col := range half {
+			i := row*half + col
+			on := bits[i/8]&(1<<(i%8)) != 0
+			grid[row][col] = on
+			grid[row][size-1-col] = on
+		}
+	}
+	return grid, nil
+}
+
+// Coordinate deterministically maps the UUID to a point on the globe, for
+// scattering entities on a map without real coordinates. Two uniform values
+// are drawn from a SHA-256 stream of the UUID's bytes, so even time-ordered
+// UUIDs sharing leading bytes land far apart. The longitude is uniform and
+// the latitude is the arcsine of a uniform value, making points uniform by
+// area on the sphere rather than clustering at the poles.
+//
+// Returns:
+//   - lat: The latitude in degrees, in [-90, 90].
+//   - lon: The longitude in degrees, in [-180, 180].
+//   - err: An error if the receiver is not a valid UUID.
+func (u UUID) Coordinate() (lat, lon float64, err error) {
+	b, err := decode(u)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Coordinate: %w", err)
+	}
+	r := expand(b, "coordinate", 16)
+	y := float64(binary.BigEndian.Uint64(r[:8])>>11) / (1 << 53)
+	x := float64(binary.BigEndian.Uint64(r[8:])>>11) / (1 << 53)
+	lat = math.Asin(2*y-1) * 180 / math.Pi
+	lon = x*360 - 180
+	return lat, lon, nil
+}
+
+// pentatonicNotes is the C major pentatonic scale from C4 to C7 as MIDI
+// note numbers; its 16 notes are indexed by a nibble.
+var pentatonicNotes = [16]int{
+	60, 62, 64, 67, 69,
+	72, 74, 76, 79, 81,
+	84, 86, 88, 91, 93,
+	96,
+}
+
+// NoteSequence deterministically maps the UUID to a tune of n MIDI note
+// numbers, for confirming IDs audibly. Notes are drawn from the C major
+// pentatonic scale between C4 (60) and C7 (96), which sounds consonant in
+// any order; each note is chosen by one nibble of a SHA-256 stream of the
+// UUID's bytes, so every note is equally likely. Two distinct UUIDs share
+// the same tune with probability 16^-n.
+//
+// Parameters:
+//   - n: The number of notes. Must be positive.
+//
+// Returns:
+//   - []int: The MIDI note numbers.
+//   - error: An error if n is not positive or the receiver is not a valid
+//     UUID.
+func (u UUID) NoteSequence(n int) ([]int, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("NoteSequence: length must be positive: %d", n)
+	}
+	b, err := decode(u)
+	if err != nil {
+		return nil, fmt.Errorf("NoteSequence: %w", err)
+	}
+	r := expand(b, "notes", (n+1)/2)
+	notes := make([]int, n)
+	for i := range notes {
+		nibble := r[i/2] >> 4
+		if i%2 == 1 {
+			nibble = r[i/2] & 0x0f
+		}
+		notes[i] = pentatonicNotes[nibble]
+	}
+	return notes, nil
+}
+
+// expand deterministically derives n bytes from b by concatenating
+// SHA-256(b || label || counter) for counter = 0, 1, ...
+func expand(b [16]byte, label string, n int) []byte {
+	out := make([]byte, 0, n+sha256.Size)
+	buf := make([]byte, 0, len(b)+len(label)+4)
+	for counter := uint32(0); len(out) < n; counter++ {
+		buf = append(buf[:0], b[:]...)
+		buf = append(buf, label...)
+		buf = binary.BigEndian.AppendUint32(buf, counter)
+		sum := sha256.Sum256(buf)
+		out = append(out, sum[:]...)
+	}
+	return out[:n]
+}
@@ -0,0 +1,149 @@
+package uuid
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestIdenticonDeterministic(t *testing.T) {
+	for _, size := range []int{1, 5, 8} {
+		a, err := testUUID.Identicon(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := testUUID.Identicon(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("Identicon(%d) differs across calls", size)
+		}
+		if len(a) != size {
+			t.Fatalf("Identicon(%d) has %d rows", size, len(a))
+		}
+		for r, row := range a {
+			if len(row) != size {
+				t.Fatalf("Identicon(%d) row %d has %d cells", size, r, len(row))
+			}
+			for c := range row {
+				if row[c] != row[size-1-c] {
+					t.Errorf("Identicon(%d) row %d is not symmetric", size, r)
+				}
+			}
+		}
+	}
+	other, _ := UUID("6f1a0b1c-8d7e-4a2b-8c9d-1e2f3a4b5c6e").Identicon(8)
+	same, _ := testUUID.Identicon(8)
+	if reflect.DeepEqual(other, same) {
+		t.Error("different UUIDs yielded the same grid")
+	}
+}
+
+func TestIdenticonErrors(t *testing.T) {
+	if _, err := testUUID.Identicon(0); err == nil {
+		t.Error("size 0: expected error")
+	}
+	if _, err := UUID("not-a-uuid").Identicon(5); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestCoordinateDeterministic(t *testing.T) {
+	lat, lon, err := testUUID.Coordinate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lat2, lon2, err := testUUID.Coordinate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lat != lat2 || lon != lon2 {
+		t.Errorf("Coordinate() not deterministic: (%v, %v) != (%v, %v)", lat, lon, lat2, lon2)
+	}
+	if _, _, err := UUID("not-a-uuid").Coordinate(); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}
+
+func TestCoordinateDistribution(t *testing.T) {
+	const n, buckets = 4000, 8
+	// Time-ordered UUIDs from one millisecond share their leading bytes.
+	now := time.Now()
+	var latCounts, lonCounts [buckets]int
+	for range n {
+		lat, lon, err := v7At(t, now).Coordinate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			t.Fatalf("Coordinate() = (%v, %v) out of range", lat, lon)
+		}
+		// Uniform by area means sin(lat) is uniform in [-1, 1].
+		s := (math.Sin(lat*math.Pi/180) + 1) / 2
+		latCounts[min(int(s*buckets), buckets-1)]++
+		lonCounts[min(int((lon+180)/360*buckets), buckets-1)]++
+	}
+	// Each bucket expects 500 with a standard deviation of about 21.
+	for i := range buckets {
+		if latCounts[i] < 400 || latCounts[i] > 600 {
+			t.Errorf("latitude bands uneven: %v", latCounts)
+			break
+		}
+	}
+	for i := range buckets {
+		if lonCounts[i] < 400 || lonCounts[i] > 600 {
+			t.Errorf("longitude bands uneven: %v", lonCounts)
+			break
+		}
+	}
+}
+
+func TestNoteSequence(t *testing.T) {
+	a, err := testUUID.NoteSequence(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := testUUID.NoteSequence(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(a, b) {
+		t.Errorf("NoteSequence() not deterministic: %v != %v", a, b)
+	}
+	for _, note := range a {
+		if note < 60 || note > 96 || !slices.Contains(pentatonicNotes[:], note) {
+			t.Errorf("note %d is outside the pentatonic range", note)
+		}
+	}
+	if short, _ := testUUID.NoteSequence(5); !slices.Equal(short, a[:5]) {
+		t.Errorf("NoteSequence(5) = %v, want a prefix of %v", short, a)
+	}
+}
+
+func TestNoteSequenceDistinct(t *testing.T) {
+	// Two 8-note tunes collide with probability 16^-8.
+	seen := make(map[[8]int]UUID)
+	for _, u := range randomUUIDs(t, 500) {
+		notes, err := u.NoteSequence(8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := [8]int(notes)
+		if prev, ok := seen[key]; ok {
+			t.Errorf("%s and %s share the tune %v", prev, u, notes)
+		}
+		seen[key] = u
+	}
+}
+
+func TestNoteSequenceErrors(t *testing.T) {
+	if _, err := testUUID.NoteSequence(0); err == nil {
+		t.Error("zero length: expected error")
+	}
+	if _, err := UUID("not-a-uuid").NoteSequence(4); err == nil {
+		t.Error("invalid UUID: expected error")
+	}
+}